@@ -0,0 +1,95 @@
+package fixed
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// fillBig sets z to the integer with the little-endian 64-bit
+// words v and returns z.
+//
+// fillBig reuses the backing array of z.Bits, so it does not
+// allocate when z already has enough capacity.
+func fillBig(z *big.Int, v []uint64) *big.Int {
+	w := z.Bits()[:0]
+	for _, u := range v {
+		if bits.UintSize == 32 {
+			w = append(w, big.Word(u), big.Word(u>>32))
+		} else {
+			w = append(w, big.Word(u))
+		}
+	}
+	// SetBits normalizes w, dropping leading zero words.
+	return z.SetBits(w)
+}
+
+// setBig sets z to the little-endian 64-bit words of v modulo
+// 2^size and reports whether v is negative or does not fit in
+// size bits.
+//
+// Negative values are stored in two's complement.
+func setBig(z []uint64, v *big.Int, size int) (overflow bool) {
+	for i := range z {
+		z[i] = 0
+	}
+	for i, w := range v.Bits() {
+		if bits.UintSize == 32 {
+			if j := i / 2; j < len(z) {
+				z[j] |= uint64(w) << (32 * (i % 2))
+			}
+		} else if i < len(z) {
+			z[i] = uint64(w)
+		}
+	}
+	neg := v.Sign() < 0
+	if neg {
+		c := uint64(1)
+		for i := range z {
+			z[i], c = bits.Add64(^z[i], 0, c)
+		}
+	}
+	if r := size % 64; r != 0 {
+		z[len(z)-1] &= 1<<r - 1
+	}
+	return neg || v.BitLen() > size
+}
+
+// newBigFloat returns v as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the precision is chosen so that v is
+// represented exactly.
+func newBigFloat(v *big.Int, prec uint, mode big.RoundingMode) *big.Float {
+	z := new(big.Float).SetMode(mode)
+	if prec != 0 {
+		z.SetPrec(prec)
+	}
+	return z.SetInt(v)
+}
+
+// roundBigFloat returns f rounded to an integer according to
+// mode.
+//
+// It returns false if f is infinite.
+func roundBigFloat(f *big.Float, mode RoundingMode) (*big.Int, bool) {
+	if f.IsInf() {
+		return nil, false
+	}
+	v, acc := f.Int(nil) // truncated toward zero
+	if acc == big.Exact {
+		return v, true
+	}
+	// frac is exact since it needs no more bits than f.
+	frac := new(big.Float).SetPrec(f.Prec())
+	frac.Sub(f, new(big.Float).SetInt(v))
+	half := frac.Abs(frac).Cmp(big.NewFloat(0.5))
+	neg := f.Signbit()
+	if mode.inc(half, false, v.Bit(0) != 0, neg) {
+		if neg {
+			v.Sub(v, big.NewInt(1))
+		} else {
+			v.Add(v, big.NewInt(1))
+		}
+	}
+	return v, true
+}
@@ -0,0 +1,137 @@
+package fixed
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestBig(t *testing.T) {
+	testBig[Uint96](t)
+	testBig[Uint128](t)
+	testBig[Uint192](t)
+	testBig[Uint256](t)
+	testBig[Uint512](t)
+	testBig[Uint1024](t)
+	testBig[Uint2048](t)
+}
+
+func testBig[T interface {
+	Uint[T]
+	ToBig() *big.Int
+	FillBig(*big.Int) *big.Int
+	ToBigFloat(uint, big.RoundingMode) *big.Float
+}, P interface {
+	*T
+	SetBig(*big.Int) bool
+	SetBigFloat(*big.Float, RoundingMode) bool
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		mod := new(big.Int).Lsh(big.NewInt(1), uint(zero.Size()))
+		for i := 0; i < 1000; i++ {
+			x := randUint[T]().Rsh(uint(rand.Intn(zero.Size())))
+			want := toBig(t, x)
+
+			if got := x.ToBig(); got.Cmp(want) != 0 {
+				t.Fatalf("ToBig: expected %s, got %s", want, got)
+			}
+			z := new(big.Int).Lsh(big.NewInt(1), uint(zero.Size()+64))
+			if got := x.FillBig(z); got != z || got.Cmp(want) != 0 {
+				t.Fatalf("FillBig: expected %s, got %s", want, got)
+			}
+
+			var got T
+			if P(&got).SetBig(want) || !got.Equal(x) {
+				t.Fatalf("SetBig(%s): expected (%s, false), got %s", want, x, got)
+			}
+			v := new(big.Int).Add(want, new(big.Int).Mul(mod, big.NewInt(rand.Int63n(100)+1)))
+			if !P(&got).SetBig(v) || !got.Equal(x) {
+				t.Fatalf("SetBig(%s): expected (%s, true), got %s", v, x, got)
+			}
+			v.Neg(want)
+			if P(&got).SetBig(v) != (v.Sign() != 0) || !got.Equal(zero.Sub(x)) {
+				t.Fatalf("SetBig(%s): expected %s, got %s", v, zero.Sub(x), got)
+			}
+
+			for _, mode := range []big.RoundingMode{big.ToNearestEven, big.ToZero, big.AwayFromZero} {
+				f := x.ToBigFloat(0, mode)
+				if f.Acc() != big.Exact {
+					t.Fatalf("ToBigFloat(0, %s): expected %s, got %s", mode, big.Exact, f.Acc())
+				}
+				if w, _ := f.Int(nil); w.Cmp(want) != 0 {
+					t.Fatalf("ToBigFloat(0, %s): expected %s, got %s", mode, want, w)
+				}
+				wf := new(big.Float).SetPrec(53).SetMode(mode).SetInt(want)
+				f = x.ToBigFloat(53, mode)
+				if f.Cmp(wf) != 0 || f.Acc() != wf.Acc() || f.Prec() != 53 {
+					t.Fatalf("ToBigFloat(53, %s): expected (%s, %s), got (%s, %s)",
+						mode, wf.Text('g', -1), wf.Acc(), f.Text('g', -1), f.Acc())
+				}
+			}
+
+			if P(&got).SetBigFloat(x.ToBigFloat(0, big.ToZero), ToZero) || !got.Equal(x) {
+				t.Fatalf("SetBigFloat(%s): expected (%s, false), got %s", x, x, got)
+			}
+		}
+
+		for _, tc := range []struct {
+			f        float64
+			mode     RoundingMode
+			want     uint64
+			overflow bool
+		}{
+			{2.5, ToNearestEven, 2, false},
+			{3.5, ToNearestEven, 4, false},
+			{2.5, ToNearestAway, 3, false},
+			{2.5, ToNearestZero, 2, false},
+			{2.75, ToNearestZero, 3, false},
+			{2.25, ToNearestAway, 2, false},
+			{2.75, ToZero, 2, false},
+			{2.25, AwayFromZero, 3, false},
+			{2.75, ToNegativeInf, 2, false},
+			{2.25, ToPositiveInf, 3, false},
+			{0, AwayFromZero, 0, false},
+			{-0.5, ToNearestEven, 0, false},
+			{-0.25, ToZero, 0, false},
+			{-0.25, ToPositiveInf, 0, false},
+			{-0.25, AwayFromZero, math.MaxUint64, true},
+			{-0.25, ToNegativeInf, math.MaxUint64, true},
+			{-2, ToZero, math.MaxUint64 - 1, true},
+		} {
+			var got T
+			overflow := P(&got).SetBigFloat(big.NewFloat(tc.f), tc.mode)
+			want := zero.Add64(tc.want)
+			if tc.overflow {
+				// Negative results wrap modulo 2^N.
+				want = zero.Sub64(math.MaxUint64 - tc.want + 1)
+			}
+			if overflow != tc.overflow || !got.Equal(want) {
+				t.Fatalf("SetBigFloat(%v, %s): expected (%s, %t), got (%s, %t)",
+					tc.f, tc.mode, want, tc.overflow, got, overflow)
+			}
+		}
+
+		huge := new(big.Float).SetMantExp(big.NewFloat(1), zero.Size())
+		for _, f := range []*big.Float{huge, new(big.Float).SetInf(false), new(big.Float).SetInf(true)} {
+			got := randUint[T]()
+			if !P(&got).SetBigFloat(f, ToZero) {
+				t.Fatalf("SetBigFloat(%s): expected an overflow", f.Text('g', 10))
+			}
+			if f.IsInf() && !got.IsZero() {
+				t.Fatalf("SetBigFloat(%s): expected 0, got %s", f.Text('g', 10), got)
+			}
+		}
+	})
+}
+
+func TestFillBigAllocs(t *testing.T) {
+	x := randUint2048()
+	z := new(big.Int).Lsh(big.NewInt(1), 2048)
+	if n := testing.AllocsPerRun(100, func() { x.FillBig(z) }); n != 0 {
+		t.Fatalf("FillBig: expected 0 allocations, got %v", n)
+	}
+}
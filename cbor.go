@@ -0,0 +1,148 @@
+package fixed
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// CBOR major types and tags. See RFC 8949.
+const (
+	cborUnsigned   = 0
+	cborNegative   = 1
+	cborByteString = 2
+	cborTag        = 6
+
+	cborTagBignum    = 2
+	cborTagNegBignum = 3
+)
+
+var (
+	errMalformedCBOR  = errors.New("fixed: malformed CBOR integer")
+	errNonMinimalCBOR = errors.New("fixed: non-deterministic CBOR integer")
+	errUnexpectedCBOR = errors.New("fixed: CBOR item is not an unsigned integer")
+	errIndefiniteCBOR = errors.New("fixed: indefinite-length CBOR item")
+)
+
+// AppendCBOR appends the CBOR encoding of x to dst and returns
+// the resulting slice.
+//
+// The encoding follows the deterministic encoding rules of RFC
+// 8949: values that fit in 64 bits are encoded as unsigned
+// integers (major type 0) with the shortest argument, and
+// larger values are encoded as unsigned bignums (tag 2) whose
+// byte string has no leading zero bytes.
+func AppendCBOR[T Uint[T]](dst []byte, x T) []byte {
+	if x.BitLen() <= 64 {
+		return appendCBORHead(dst, cborUnsigned, x.uint64())
+	}
+	n := (x.BitLen() + 7) / 8
+	dst = appendCBORHead(dst, cborTag, cborTagBignum)
+	dst = appendCBORHead(dst, cborByteString, uint64(n))
+	return appendBigEndian(dst, x, n)
+}
+
+// ParseCBOR decodes a CBOR unsigned integer or unsigned bignum
+// created by [AppendCBOR] from the start of b.
+//
+// It returns the integer and the remainder of b after the item.
+// Decoding is strict: it returns an error if the item is not
+// encoded deterministically, is negative, or does not fit in
+// a T.
+func ParseCBOR[T Uint[T]](b []byte) (x T, rest []byte, err error) {
+	major, v, n, err := parseCBORHead(b)
+	if err != nil {
+		return x, b, err
+	}
+	switch major {
+	case cborUnsigned:
+		return x.Add64(v), b[n:], nil
+	case cborNegative:
+		return x, b, errNegative
+	case cborTag:
+		switch v {
+		case cborTagBignum:
+		case cborTagNegBignum:
+			return x, b, errNegative
+		default:
+			return x, b, errUnexpectedCBOR
+		}
+	default:
+		return x, b, errUnexpectedCBOR
+	}
+
+	major, v, m, err := parseCBORHead(b[n:])
+	if err != nil {
+		return x, b, err
+	}
+	if major != cborByteString {
+		return x, b, errMalformedCBOR
+	}
+	n += m
+	if v > uint64(len(b)-n) {
+		return x, b, errMalformedCBOR
+	}
+	c := b[n : n+int(v)]
+	switch {
+	case len(c) > 0 && c[0] == 0:
+		return x, b, errNonMinimalCBOR
+	case len(c) <= 8:
+		// Values that fit in 64 bits must use major type 0.
+		return x, b, errNonMinimalCBOR
+	case len(c) > x.Size()/8:
+		return x, b, ErrOverflow
+	}
+	return parseBigEndian[T](c), b[n+len(c):], nil
+}
+
+// appendCBORHead appends the initial byte and shortest argument
+// for a CBOR data item to dst.
+func appendCBORHead(dst []byte, major byte, v uint64) []byte {
+	major <<= 5
+	switch {
+	case v < 24:
+		return append(dst, major|byte(v))
+	case v <= math.MaxUint8:
+		return append(dst, major|24, byte(v))
+	case v <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, major|25), uint16(v))
+	case v <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, major|26), uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(append(dst, major|27), v)
+	}
+}
+
+// parseCBORHead parses the initial byte and argument of a CBOR
+// data item and returns the major type, the argument, and the
+// length of the head.
+//
+// It rejects indefinite lengths and arguments that are not
+// encoded in the shortest form.
+func parseCBORHead(b []byte) (major byte, v uint64, n int, err error) {
+	if len(b) == 0 {
+		return 0, 0, 0, errMalformedCBOR
+	}
+	major, ai := b[0]>>5, b[0]&0x1f
+	var min uint64
+	switch {
+	case ai < 24:
+		return major, uint64(ai), 1, nil
+	case ai == 24 && len(b) >= 2:
+		v, n, min = uint64(b[1]), 2, 24
+	case ai == 25 && len(b) >= 3:
+		v, n, min = uint64(binary.BigEndian.Uint16(b[1:])), 3, math.MaxUint8+1
+	case ai == 26 && len(b) >= 5:
+		v, n, min = uint64(binary.BigEndian.Uint32(b[1:])), 5, math.MaxUint16+1
+	case ai == 27 && len(b) >= 9:
+		v, n, min = binary.BigEndian.Uint64(b[1:]), 9, math.MaxUint32+1
+	case ai == 31:
+		return 0, 0, 0, errIndefiniteCBOR
+	default:
+		return 0, 0, 0, errMalformedCBOR
+	}
+	if v < min {
+		return 0, 0, 0, errNonMinimalCBOR
+	}
+	return major, v, n, nil
+}
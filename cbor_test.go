@@ -0,0 +1,119 @@
+package fixed
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCBOR(t *testing.T) {
+	testCBOR[Uint96](t)
+	testCBOR[Uint128](t)
+	testCBOR[Uint192](t)
+	testCBOR[Uint256](t)
+	testCBOR[Uint512](t)
+	testCBOR[Uint1024](t)
+	testCBOR[Uint2048](t)
+}
+
+func testCBOR[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size()
+
+		// Examples from RFC 8949, Appendix A.
+		for _, tc := range []struct {
+			x    string
+			want string
+		}{
+			{"0", "00"},
+			{"1", "01"},
+			{"10", "0a"},
+			{"23", "17"},
+			{"24", "1818"},
+			{"25", "1819"},
+			{"100", "1864"},
+			{"1000", "1903e8"},
+			{"1000000", "1a000f4240"},
+			{"1000000000000", "1b000000e8d4a51000"},
+			{"18446744073709551615", "1bffffffffffffffff"},
+			{"18446744073709551616", "c249010000000000000000"},
+		} {
+			x, _, _, err := parseUint[T](tc.x, 10, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(AppendCBOR(nil, x)); got != tc.want {
+				t.Fatalf("AppendCBOR(%s): expected %s, got %s", tc.x, tc.want, got)
+			}
+		}
+
+		for i := 0; i < 1000; i++ {
+			x := randUint[T]().Rsh(uint(rand.Intn(size)))
+			b := AppendCBOR([]byte("p"), x)
+
+			var want []byte
+			if x.BitLen() <= 64 {
+				want = appendCBORHead(nil, cborUnsigned, x.uint64())
+			} else {
+				c := toBig(t, x).Bytes()
+				want = appendCBORHead([]byte{0xc2}, cborByteString, uint64(len(c)))
+				want = append(want, c...)
+			}
+			if !bytes.Equal(b[1:], want) {
+				t.Fatalf("AppendCBOR(%s): expected %x, got %x", x, want, b[1:])
+			}
+
+			got, rest, err := ParseCBOR[T](append(b[1:], 0xf6))
+			if err != nil {
+				t.Fatalf("ParseCBOR(%x): %v", b[1:], err)
+			}
+			if !got.Equal(x) || !bytes.Equal(rest, []byte{0xf6}) {
+				t.Fatalf("ParseCBOR(%x): expected (%s, f6), got (%s, %x)", b[1:], x, got, rest)
+			}
+		}
+
+		tooBig := append([]byte{0xc2}, appendCBORHead(nil, cborByteString, uint64(size/8+1))...)
+		tooBig = append(tooBig, 1)
+		tooBig = append(tooBig, make([]byte, size/8)...)
+		for _, tc := range []struct {
+			b   string
+			err error
+		}{
+			{"", errMalformedCBOR},
+			{"18", errMalformedCBOR},
+			{"1900", errMalformedCBOR},
+			{"1c", errMalformedCBOR},
+			{"1f", errIndefiniteCBOR},
+			{"1817", errNonMinimalCBOR},
+			{"1900ff", errNonMinimalCBOR},
+			{"1a0000ffff", errNonMinimalCBOR},
+			{"1b00000000ffffffff", errNonMinimalCBOR},
+			{"20", errNegative},
+			{"c349010000000000000000", errNegative},
+			{"c1", errUnexpectedCBOR},
+			{"40", errUnexpectedCBOR},
+			{"f6", errUnexpectedCBOR},
+			{"c200", errMalformedCBOR},
+			{"c249010000", errMalformedCBOR},
+			{"c25f", errIndefiniteCBOR},
+			{"c2480100000000000000", errNonMinimalCBOR},
+			{"c24a00010000000000000000", errNonMinimalCBOR},
+			{"c240", errNonMinimalCBOR},
+			{"c2590009010000000000000000", errNonMinimalCBOR},
+			{hex.EncodeToString(tooBig), ErrOverflow},
+		} {
+			b, err := hex.DecodeString(tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, rest, err := ParseCBOR[T](b); !errors.Is(err, tc.err) || !bytes.Equal(rest, b) {
+				t.Fatalf("ParseCBOR(%s): expected %v, got %v", tc.b, tc.err, err)
+			}
+		}
+	})
+}
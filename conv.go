@@ -8,7 +8,6 @@ import (
 
 const (
 	maxUint64Digits = 20
-	maxUint96Digits = 29
 )
 
 var pow10tab = [...]uint64{
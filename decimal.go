@@ -0,0 +1,355 @@
+package fixed
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var errNoRounding = errors.New("fixed: Quo requires a Context with Round set")
+
+// Context controls the precision and rounding of [Decimal]
+// arithmetic.
+//
+// The zero value performs exact arithmetic: Add, Sub, and Mul
+// never round, and Quo returns an error.
+type Context struct {
+	// Round enables rounding. If false, Precision and Mode are
+	// ignored.
+	Round bool
+	// Precision is the maximum number of digits after the
+	// decimal point in the result of an operation.
+	//
+	// Results with more digits are rounded according to Mode.
+	Precision int
+	// Mode determines how inexact results are rounded.
+	Mode RoundingMode
+}
+
+// roundCtx returns x rounded according to ctx.
+func roundCtx[T Uint[T]](x Decimal[T], ctx Context) Decimal[T] {
+	if !ctx.Round {
+		return x
+	}
+	return x.Round(ctx.Precision, ctx.Mode)
+}
+
+// Decimal is a fixed-point decimal number with a coefficient of
+// type T.
+//
+// The value of a Decimal is
+//
+//	(-1)^neg * coef * 10^-scale
+//
+// The zero value is 0 with a scale of 0.
+//
+// Decimals with the same value but different scales (e.g.,
+// 1.5 and 1.50) are distinct and cannot be compared with ==;
+// use Cmp instead.
+type Decimal[T Uint[T]] struct {
+	coef  T
+	scale int
+	neg   bool
+}
+
+// NewDecimal returns the non-negative decimal coef * 10^-scale.
+func NewDecimal[T Uint[T]](coef T, scale int) Decimal[T] {
+	return Decimal[T]{coef: coef, scale: scale}
+}
+
+func mkdec[T Uint[T]](coef T, scale int, neg bool) Decimal[T] {
+	return Decimal[T]{coef: coef, scale: scale, neg: neg && !coef.IsZero()}
+}
+
+// Coef returns the absolute value of the coefficient of x.
+func (x Decimal[T]) Coef() T {
+	return x.coef
+}
+
+// Scale returns the number of digits after the decimal point
+// in x.
+//
+// A negative scale indicates that the coefficient is multiplied
+// by a positive power of ten.
+func (x Decimal[T]) Scale() int {
+	return x.scale
+}
+
+// IsZero reports whether x is zero.
+func (x Decimal[T]) IsZero() bool {
+	return x.coef.IsZero()
+}
+
+// Sign returns
+//
+//   - -1 if x < 0
+//   - 0 if x == 0
+//   - +1 if x > 0
+func (x Decimal[T]) Sign() int {
+	switch {
+	case x.coef.IsZero():
+		return 0
+	case x.neg:
+		return -1
+	default:
+		return +1
+	}
+}
+
+// Neg returns -x.
+func (x Decimal[T]) Neg() Decimal[T] {
+	return mkdec(x.coef, x.scale, !x.neg)
+}
+
+// Abs returns |x|.
+func (x Decimal[T]) Abs() Decimal[T] {
+	return mkdec(x.coef, x.scale, false)
+}
+
+// Cmp compares x and y and returns
+//
+//   - +1 if x > y
+//   - 0 if x == y
+//   - -1 if x < y
+func (x Decimal[T]) Cmp(y Decimal[T]) int {
+	xs, ys := x.Sign(), y.Sign()
+	switch {
+	case xs < ys:
+		return -1
+	case xs > ys:
+		return +1
+	}
+	c := cmpAbs(x, y)
+	if xs < 0 {
+		return -c
+	}
+	return c
+}
+
+// cmpAbs compares |x| and |y|.
+func cmpAbs[T Uint[T]](x, y Decimal[T]) int {
+	switch {
+	case x.scale < y.scale:
+		xc, ok := scaleUp(x.coef, y.scale-x.scale)
+		if !ok {
+			return +1
+		}
+		return xc.Cmp(y.coef)
+	case x.scale > y.scale:
+		yc, ok := scaleUp(y.coef, x.scale-y.scale)
+		if !ok {
+			return -1
+		}
+		return x.coef.Cmp(yc)
+	default:
+		return x.coef.Cmp(y.coef)
+	}
+}
+
+// Rescale returns x with the provided scale, rounding according
+// to mode if the scale is reduced.
+//
+// It returns ErrOverflow if the coefficient cannot represent
+// x at the new scale.
+func (x Decimal[T]) Rescale(scale int, mode RoundingMode) (Decimal[T], error) {
+	if scale >= x.scale {
+		coef, ok := scaleUp(x.coef, scale-x.scale)
+		if !ok {
+			return Decimal[T]{}, ErrOverflow
+		}
+		return mkdec(coef, scale, x.neg), nil
+	}
+	coef := scaleDown(x.coef, x.scale-scale, false, x.neg, mode)
+	return mkdec(coef, scale, x.neg), nil
+}
+
+// Round returns x rounded to at most n digits after the decimal
+// point according to mode.
+func (x Decimal[T]) Round(n int, mode RoundingMode) Decimal[T] {
+	if x.scale <= n {
+		return x
+	}
+	coef := scaleDown(x.coef, x.scale-n, false, x.neg, mode)
+	return mkdec(coef, n, x.neg)
+}
+
+// Truncate returns x truncated to at most n digits after the
+// decimal point.
+//
+// It is shorthand for x.Round(n, ToZero).
+func (x Decimal[T]) Truncate(n int) Decimal[T] {
+	return x.Round(n, ToZero)
+}
+
+// Add returns x+y, rounded according to ctx.
+//
+// It returns ErrOverflow if the result cannot be represented.
+func (x Decimal[T]) Add(y Decimal[T], ctx Context) (Decimal[T], error) {
+	// Align both coefficients to the larger scale.
+	if x.scale < y.scale {
+		x, y = y, x
+	}
+	yc, ok := scaleUp(y.coef, x.scale-y.scale)
+	if !ok {
+		return Decimal[T]{}, ErrOverflow
+	}
+	xc := x.coef
+
+	var z Decimal[T]
+	if x.neg == y.neg {
+		coef := xc.Add(yc)
+		if coef.Cmp(xc) < 0 {
+			return Decimal[T]{}, ErrOverflow
+		}
+		z = mkdec(coef, x.scale, x.neg)
+	} else if xc.Cmp(yc) >= 0 {
+		z = mkdec(xc.Sub(yc), x.scale, x.neg)
+	} else {
+		z = mkdec(yc.Sub(xc), x.scale, y.neg)
+	}
+	return roundCtx(z, ctx), nil
+}
+
+// Sub returns x-y, rounded according to ctx.
+//
+// It returns ErrOverflow if the result cannot be represented.
+func (x Decimal[T]) Sub(y Decimal[T], ctx Context) (Decimal[T], error) {
+	return x.Add(y.Neg(), ctx)
+}
+
+// Mul returns x*y, rounded according to ctx.
+//
+// It returns ErrOverflow if the product of the coefficients
+// cannot be represented.
+func (x Decimal[T]) Mul(y Decimal[T], ctx Context) (Decimal[T], error) {
+	coef, ok := x.coef.MulCheck(y.coef)
+	if !ok {
+		return Decimal[T]{}, ErrOverflow
+	}
+	z := mkdec(coef, x.scale+y.scale, x.neg != y.neg)
+	return roundCtx(z, ctx), nil
+}
+
+// Quo returns x/y rounded to exactly ctx.Precision digits after
+// the decimal point.
+//
+// Quotients are generally inexact, so Quo returns an error if
+// ctx.Round is false. It returns ErrDivisionByZero if y is zero
+// and ErrOverflow if the coefficient of x scaled to
+// ctx.Precision cannot be represented.
+func (x Decimal[T]) Quo(y Decimal[T], ctx Context) (Decimal[T], error) {
+	if !ctx.Round {
+		return Decimal[T]{}, errNoRounding
+	}
+	if y.coef.IsZero() {
+		return Decimal[T]{}, ErrDivisionByZero
+	}
+	neg := x.neg != y.neg
+
+	// The quotient of the coefficients has a scale of
+	// x.scale - y.scale, so it must be shifted by k digits.
+	k := ctx.Precision - x.scale + y.scale
+	if k < 0 {
+		q, r := x.coef.QuoRem(y.coef)
+		coef := scaleDown(q, -k, !r.IsZero(), neg, ctx.Mode)
+		return mkdec(coef, ctx.Precision, neg), nil
+	}
+	num, ok := scaleUp(x.coef, k)
+	if !ok {
+		return Decimal[T]{}, ErrOverflow
+	}
+	q, r := num.QuoRem(y.coef)
+	q, ok = roundQuo(q, r, y.coef, false, neg, ctx.Mode)
+	if !ok {
+		return Decimal[T]{}, ErrOverflow
+	}
+	return mkdec(q, ctx.Precision, neg), nil
+}
+
+// String returns the decimal representation of x.
+//
+// Trailing zeros after the decimal point are preserved. If the
+// scale is negative, the coefficient is followed by an exponent
+// (for example, "12e6"). Either way, the result round trips
+// through ParseDecimal with the same coefficient and scale.
+func (x Decimal[T]) String() string {
+	digits := x.coef.String()
+
+	var b strings.Builder
+	if x.neg {
+		b.WriteByte('-')
+	}
+	switch {
+	case x.scale == 0:
+		b.WriteString(digits)
+	case x.scale < 0:
+		b.WriteString(digits)
+		b.WriteByte('e')
+		b.WriteString(strconv.Itoa(-x.scale))
+	case len(digits) <= x.scale:
+		b.WriteString("0.")
+		b.WriteString(strings.Repeat("0", x.scale-len(digits)))
+		b.WriteString(digits)
+	default:
+		n := len(digits) - x.scale
+		b.WriteString(digits[:n])
+		b.WriteByte('.')
+		b.WriteString(digits[n:])
+	}
+	return b.String()
+}
+
+// ParseDecimal returns the value of the base-10 decimal s.
+//
+// The syntax is an optional sign, followed by digits with an
+// optional decimal point, followed by an optional exponent.
+// For example, "123.4500", "-0.5", and "1.2e-7" are valid.
+//
+// The scale of the result is the number of digits after the
+// decimal point less the exponent.
+func ParseDecimal[T Uint[T]](s string) (Decimal[T], error) {
+	const fnParseDecimal = "ParseDecimal"
+
+	if s == "" {
+		return Decimal[T]{}, syntaxError(fnParseDecimal, s)
+	}
+
+	s0 := s
+	neg := false
+	switch s[0] {
+	case '+':
+		s = s[1:]
+	case '-':
+		neg = true
+		s = s[1:]
+	}
+
+	coef, end, dotIdx, err := parseUint[T](s, 10, true)
+	if err != nil {
+		err.(*strconv.NumError).Func = fnParseDecimal
+		err.(*strconv.NumError).Num = cloneString(s0)
+		return Decimal[T]{}, err
+	}
+
+	ndigits := end
+	scale := 0
+	if dotIdx >= 0 {
+		ndigits--
+		scale = end - dotIdx - 1
+	}
+	if ndigits == 0 {
+		return Decimal[T]{}, syntaxError(fnParseDecimal, s0)
+	}
+	if end < len(s) {
+		// s[end] is 'e' or 'E'.
+		exp, err := strconv.ParseInt(s[end+1:], 10, 32)
+		if err != nil {
+			if err.(*strconv.NumError).Err == strconv.ErrRange {
+				return Decimal[T]{}, rangeError(fnParseDecimal, s0)
+			}
+			return Decimal[T]{}, syntaxError(fnParseDecimal, s0)
+		}
+		scale -= int(exp)
+	}
+	return mkdec(coef, scale, neg), nil
+}
@@ -0,0 +1,326 @@
+package fixed
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// bigRound returns num/den rounded to an integer according to
+// mode.
+func bigRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	if den.Sign() < 0 {
+		num = new(big.Int).Neg(num)
+		den = new(big.Int).Neg(den)
+	}
+	neg := num.Sign() < 0
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(num), den, new(big.Int))
+	half := new(big.Int).Lsh(r, 1).Cmp(den)
+	if mode.inc(half, r.Sign() == 0, q.Bit(0) != 0, neg) {
+		q.Add(q, big.NewInt(1))
+	}
+	if neg {
+		q.Neg(q)
+	}
+	return q
+}
+
+func (x Decimal[T]) rat() *big.Rat {
+	var v big.Int
+	if _, ok := v.SetString(x.coef.String(), 10); !ok {
+		panic("invalid coefficient")
+	}
+	if x.neg {
+		v.Neg(&v)
+	}
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(x.scale))), nil)
+	if x.scale < 0 {
+		return new(big.Rat).SetInt(v.Mul(&v, p))
+	}
+	return new(big.Rat).SetFrac(&v, p)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func randDecimal() Decimal[Uint128] {
+	coef := U128From64(rand.Uint64() >> uint(24+rand.Intn(40)))
+	return mkdec(coef, rand.Intn(12), randBool())
+}
+
+var roundingModes = []RoundingMode{
+	ToNearestEven,
+	ToNearestAway,
+	ToNearestZero,
+	ToZero,
+	AwayFromZero,
+	ToNegativeInf,
+	ToPositiveInf,
+}
+
+// checkDecimal checks that got is want rounded to prec digits
+// after the decimal point.
+func checkDecimal(t *testing.T, op string, got Decimal[Uint128], want *big.Rat, prec int, mode RoundingMode) {
+	t.Helper()
+
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(prec)), nil)
+	v := new(big.Rat).Mul(want, new(big.Rat).SetInt(p))
+	coef := bigRound(v.Num(), v.Denom(), mode)
+	exact := new(big.Rat).SetFrac(coef, p)
+	if got.rat().Cmp(exact) != 0 {
+		t.Fatalf("%s (%s, %d): expected %s, got %s",
+			op, mode, prec, exact.FloatString(prec), got)
+	}
+}
+
+func TestDecimalArith(t *testing.T) {
+	for i := 0; i < 20_000; i++ {
+		x := randDecimal()
+		y := randDecimal()
+		ctx := Context{
+			Round:     true,
+			Precision: rand.Intn(12),
+			Mode:      roundingModes[rand.Intn(len(roundingModes))],
+		}
+
+		z, err := x.Add(y, ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := new(big.Rat).Add(x.rat(), y.rat())
+		checkDecimal(t, fmt.Sprintf("%s + %s", x, y), z, want, ctx.Precision, ctx.Mode)
+
+		z, err = x.Sub(y, ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = new(big.Rat).Sub(x.rat(), y.rat())
+		checkDecimal(t, fmt.Sprintf("%s - %s", x, y), z, want, ctx.Precision, ctx.Mode)
+
+		z, err = x.Mul(y, ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = new(big.Rat).Mul(x.rat(), y.rat())
+		checkDecimal(t, fmt.Sprintf("%s * %s", x, y), z, want, ctx.Precision, ctx.Mode)
+
+		if y.IsZero() {
+			if _, err := x.Quo(y, ctx); !errors.Is(err, ErrDivisionByZero) {
+				t.Fatalf("expected %v, got %v", ErrDivisionByZero, err)
+			}
+			continue
+		}
+		z, err = x.Quo(y, ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z.Scale() != ctx.Precision {
+			t.Fatalf("expected scale %d, got %d", ctx.Precision, z.Scale())
+		}
+		want = new(big.Rat).Quo(x.rat(), y.rat())
+		checkDecimal(t, fmt.Sprintf("%s / %s", x, y), z, want, ctx.Precision, ctx.Mode)
+	}
+}
+
+func TestDecimalExact(t *testing.T) {
+	x, err := ParseDecimal[Uint128]("1.005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := ParseDecimal[Uint128]("3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The zero Context never rounds.
+	var ctx Context
+	z, err := x.Add(y, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := z.String(); got != "4.005" {
+		t.Fatalf("Add: expected %q, got %q", "4.005", got)
+	}
+	z, err = x.Sub(y, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := z.String(); got != "-1.995" {
+		t.Fatalf("Sub: expected %q, got %q", "-1.995", got)
+	}
+	z, err = x.Mul(x, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := z.String(); got != "1.010025" {
+		t.Fatalf("Mul: expected %q, got %q", "1.010025", got)
+	}
+	if _, err := x.Quo(y, ctx); !errors.Is(err, errNoRounding) {
+		t.Fatalf("Quo: expected %v, got %v", errNoRounding, err)
+	}
+
+	// Rounding to zero digits must be requested explicitly.
+	z, err = x.Add(y, Context{Round: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := z.String(); got != "4" {
+		t.Fatalf("Add: expected %q, got %q", "4", got)
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	for i := 0; i < 20_000; i++ {
+		x := randDecimal()
+		y := randDecimal()
+		if got, want := x.Cmp(y), x.rat().Cmp(y.rat()); got != want {
+			t.Fatalf("Cmp(%s, %s): expected %d, got %d", x, y, want, got)
+		}
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	for _, tc := range []struct {
+		x    string
+		n    int
+		mode RoundingMode
+		want string
+	}{
+		{"2.5", 0, ToNearestEven, "2"},
+		{"3.5", 0, ToNearestEven, "4"},
+		{"2.5", 0, ToNearestAway, "3"},
+		{"2.5", 0, ToNearestZero, "2"},
+		{"-2.5", 0, ToNearestAway, "-3"},
+		{"-2.5", 0, ToNegativeInf, "-3"},
+		{"-2.5", 0, ToPositiveInf, "-2"},
+		{"2.1", 0, AwayFromZero, "3"},
+		{"2.9", 0, ToZero, "2"},
+		{"1.2345", 2, ToNearestEven, "1.23"},
+		{"1.2355", 3, ToNearestEven, "1.236"},
+		{"1.2", 4, ToNearestEven, "1.2"},
+		{"-0.001", 2, ToNearestEven, "0.00"},
+		{"999.5", 0, ToNearestEven, "1000"},
+		{"0.5", -1, ToNearestEven, "0e1"},
+		{"5", -1, ToNearestAway, "1e1"},
+	} {
+		x, err := ParseDecimal[Uint128](tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := x.Round(tc.n, tc.mode).String(); got != tc.want {
+			t.Fatalf("Round(%s, %d, %s): expected %q, got %q",
+				tc.x, tc.n, tc.mode, tc.want, got)
+		}
+	}
+}
+
+func TestDecimalRescale(t *testing.T) {
+	x, err := ParseDecimal[Uint96]("1.25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, err := x.Rescale(4, ToZero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := z.String(); got != "1.2500" {
+		t.Fatalf("expected %q, got %q", "1.2500", got)
+	}
+	z, err = x.Rescale(1, ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := z.String(); got != "1.2" {
+		t.Fatalf("expected %q, got %q", "1.2", got)
+	}
+	if got := x.Truncate(1).String(); got != "1.2" {
+		t.Fatalf("expected %q, got %q", "1.2", got)
+	}
+	if _, err := x.Rescale(40, ToZero); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected %v, got %v", ErrOverflow, err)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		coef  uint64
+		scale int
+		str   string
+	}{
+		{"0", 0, 0, "0"},
+		{"-0", 0, 0, "0"},
+		{"123.4500", 1234500, 4, "123.4500"},
+		{"-123.45", 12345, 2, "-123.45"},
+		{"+1", 1, 0, "1"},
+		{".5", 5, 1, "0.5"},
+		{"5.", 5, 0, "5"},
+		{"1.2e-7", 12, 8, "0.00000012"},
+		{"1.2E7", 12, -6, "12e6"},
+		{"1e+2", 1, -2, "1e2"},
+		{"0.000", 0, 3, "0.000"},
+		{"0e3", 0, -3, "0e3"},
+		{"-1e3", 1, -3, "-1e3"},
+	} {
+		x, err := ParseDecimal[Uint256](tc.s)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tc.s, err)
+		}
+		if x.Coef() != U256From64(tc.coef) || x.Scale() != tc.scale {
+			t.Fatalf("%q: expected (%d, %d), got (%s, %d)",
+				tc.s, tc.coef, tc.scale, x.Coef(), x.Scale())
+		}
+		if got := x.String(); got != tc.str {
+			t.Fatalf("%q: expected %q, got %q", tc.s, tc.str, got)
+		}
+	}
+
+	for _, s := range []string{
+		"",
+		"-",
+		".",
+		"e5",
+		"1e",
+		"1.2.3",
+		"1x",
+		"--1",
+		"1e5.0",
+		"1e99999999999",
+		"100000000000000000000000000000",
+	} {
+		if _, err := ParseDecimal[Uint96](s); err == nil {
+			t.Fatalf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestDecimalStringRoundTrip(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		want := randDecimal()
+		got, err := ParseDecimal[Uint128](want.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("expected %s, got %s", want, got)
+		}
+
+		// Negative scales use an exponent.
+		want = mkdec(want.coef, -rand.Intn(12), want.neg)
+		got, err = ParseDecimal[Uint128](want.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("expected (%s, %d), got (%s, %d)",
+				want, want.Scale(), got, got.Scale())
+		}
+	}
+}
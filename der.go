@@ -0,0 +1,132 @@
+package fixed
+
+import "errors"
+
+// tagInteger is the ASN.1 tag for an INTEGER.
+const tagInteger = 0x02
+
+var (
+	errMalformedDER  = errors.New("fixed: malformed DER integer")
+	errNonMinimalDER = errors.New("fixed: non-minimal DER integer")
+	errTrailingDER   = errors.New("fixed: trailing data after DER integer")
+)
+
+// AddASN1 appends x to b as a DER-encoded ASN.1 INTEGER.
+//
+// It is compatible with [golang.org/x/crypto/cryptobyte]:
+//
+//	var b cryptobyte.Builder
+//	b.AddASN1(asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+//		fixed.AddASN1(b, r)
+//		fixed.AddASN1(b, s)
+//	})
+func AddASN1[T Uint[T]](b interface{ AddBytes([]byte) }, x T) {
+	// buf is large enough for the widest integer type.
+	var buf [4 + 2048/8 + 1]byte
+	b.AddBytes(appendDER(buf[:0], x))
+}
+
+// ReadASN1 decodes a DER-encoded ASN.1 INTEGER from the start of
+// s into out and advances s past it.
+//
+// It reports whether the read was successful. It fails if the
+// INTEGER is negative, not minimally encoded, or does not fit
+// in a T. It is compatible with
+// [golang.org/x/crypto/cryptobyte.String]:
+//
+//	var r, s fixed.Uint256
+//	if !input.ReadASN1(&sig, asn1.SEQUENCE) ||
+//		!fixed.ReadASN1(&sig, &r) ||
+//		!fixed.ReadASN1(&sig, &s) {
+//		// invalid signature
+//	}
+func ReadASN1[T Uint[T], S ~[]byte](s *S, out *T) bool {
+	x, rest, err := parseDER[T](*s)
+	if err != nil {
+		return false
+	}
+	*s, *out = S(rest), x
+	return true
+}
+
+// appendDER appends the DER encoding of x as an ASN.1 INTEGER to
+// dst and returns the resulting slice.
+func appendDER[T Uint[T]](dst []byte, x T) []byte {
+	n := (x.BitLen() + 7) / 8
+	// Prefix zero and integers with the high bit set with 0x00
+	// so that they are positive.
+	pad := x.BitLen()%8 == 0
+	length := n
+	if pad {
+		length++
+	}
+
+	dst = append(dst, tagInteger)
+	switch {
+	case length < 0x80:
+		dst = append(dst, byte(length))
+	case length <= 0xff:
+		dst = append(dst, 0x81, byte(length))
+	default:
+		dst = append(dst, 0x82, byte(length>>8), byte(length))
+	}
+	if pad {
+		dst = append(dst, 0)
+	}
+	return appendBigEndian(dst, x, n)
+}
+
+// parseDER decodes a DER-encoded ASN.1 INTEGER from the start
+// of b and returns the integer and the remainder of b.
+func parseDER[T Uint[T]](b []byte) (x T, rest []byte, err error) {
+	if len(b) < 2 || b[0] != tagInteger {
+		return x, b, errMalformedDER
+	}
+	length, i := int(b[1]), 2
+	if length >= 0x80 {
+		// Long form. DER requires the minimal number of length
+		// bytes, so lengths under 128 must use the short form.
+		// Two bytes are enough for every integer type.
+		switch n := length & 0x7f; {
+		case n == 1 && len(b) >= 3:
+			length, i = int(b[2]), 3
+		case n == 2 && len(b) >= 4 && b[2] != 0:
+			length, i = int(b[2])<<8|int(b[3]), 4
+		default:
+			return x, b, errMalformedDER
+		}
+		if length < 0x80 {
+			return x, b, errNonMinimalDER
+		}
+	}
+	if length == 0 || len(b)-i < length {
+		return x, b, errMalformedDER
+	}
+	c := b[i : i+length]
+	if len(c) > 1 && (c[0] == 0 && c[1]&0x80 == 0 || c[0] == 0xff && c[1]&0x80 != 0) {
+		return x, b, errNonMinimalDER
+	}
+	if c[0]&0x80 != 0 {
+		return x, b, errNegative
+	}
+	if c[0] == 0 {
+		c = c[1:]
+	}
+	if len(c) > x.Size()/8 {
+		return x, b, ErrOverflow
+	}
+	return parseBigEndian[T](c), b[i+length:], nil
+}
+
+// unmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+func unmarshalDER[T Uint[T]](x *T, data []byte) error {
+	v, rest, err := parseDER[T](data)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errTrailingDER
+	}
+	*x = v
+	return nil
+}
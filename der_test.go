@@ -0,0 +1,128 @@
+package fixed
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// asn1Builder mimics cryptobyte.Builder.
+type asn1Builder struct {
+	buf []byte
+}
+
+func (b *asn1Builder) AddBytes(v []byte) {
+	b.buf = append(b.buf, v...)
+}
+
+// asn1String mimics cryptobyte.String.
+type asn1String []byte
+
+func TestDER(t *testing.T) {
+	testDER[Uint96](t)
+	testDER[Uint128](t)
+	testDER[Uint192](t)
+	testDER[Uint256](t)
+	testDER[Uint512](t)
+	testDER[Uint1024](t)
+	testDER[Uint2048](t)
+}
+
+func testDER[T interface {
+	Uint[T]
+	MarshalDER() ([]byte, error)
+}, P interface {
+	*T
+	UnmarshalDER([]byte) error
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size()
+		xs := []T{zero, zero.Add64(0x7f), zero.Add64(0x80), zero.max()}
+		for i := 0; i < 1000; i++ {
+			xs = append(xs, randUint[T]().Rsh(uint(rand.Intn(size))))
+		}
+		for _, x := range xs {
+			v := toBig(t, x)
+			want, err := asn1.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := x.MarshalDER()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("MarshalDER(%s): expected %x, got %x", x, want, got)
+			}
+
+			var y T
+			if err := P(&y).UnmarshalDER(want); err != nil {
+				t.Fatalf("UnmarshalDER(%x): %v", want, err)
+			}
+			if !y.Equal(x) {
+				t.Fatalf("UnmarshalDER(%x): expected %s, got %s", want, x, y)
+			}
+
+			// Two integers in a row, as in an ECDSA signature.
+			var b asn1Builder
+			AddASN1(&b, x)
+			AddASN1(&b, y)
+			if !bytes.Equal(b.buf, append(want, want...)) {
+				t.Fatalf("AddASN1(%s): expected %x, got %x", x, want, b.buf)
+			}
+			s := asn1String(b.buf)
+			var r1, r2 T
+			if !ReadASN1(&s, &r1) || !ReadASN1(&s, &r2) || len(s) != 0 {
+				t.Fatalf("ReadASN1(%x): failed", b.buf)
+			}
+			if !r1.Equal(x) || !r2.Equal(x) {
+				t.Fatalf("ReadASN1(%x): expected %s, got (%s, %s)", b.buf, x, r1, r2)
+			}
+			if ReadASN1(&s, &r1) {
+				t.Fatal("ReadASN1: expected failure on empty input")
+			}
+		}
+
+		overflow := new(big.Int).Lsh(big.NewInt(1), uint(size))
+		tooBig, _ := asn1.Marshal(overflow)
+		negative, _ := asn1.Marshal(big.NewInt(-1))
+		for _, tc := range []struct {
+			b   []byte
+			err error
+		}{
+			{nil, errMalformedDER},
+			{[]byte{0x02}, errMalformedDER},
+			{[]byte{0x02, 0x00}, errMalformedDER},
+			{[]byte{0x04, 0x01, 0x00}, errMalformedDER},
+			{[]byte{0x02, 0x02, 0x00}, errMalformedDER},
+			{[]byte{0x02, 0x80}, errMalformedDER},
+			{[]byte{0x02, 0x83, 0x00, 0x00, 0x01, 0x00}, errMalformedDER},
+			{[]byte{0x02, 0x82, 0x00, 0x01, 0x00}, errMalformedDER},
+			{[]byte{0x02, 0x81, 0x01, 0x00}, errNonMinimalDER},
+			{[]byte{0x02, 0x02, 0x00, 0x01}, errNonMinimalDER},
+			{[]byte{0x02, 0x02, 0xff, 0x80}, errNonMinimalDER},
+			{[]byte{0x02, 0x01, 0x80}, errNegative},
+			{negative, errNegative},
+			{tooBig, ErrOverflow},
+			{[]byte{0x02, 0x01, 0x00, 0x00}, errTrailingDER},
+		} {
+			var x T
+			if err := P(&x).UnmarshalDER(tc.b); !errors.Is(err, tc.err) {
+				t.Fatalf("UnmarshalDER(%x): expected %v, got %v", tc.b, tc.err, err)
+			}
+			if tc.err == errTrailingDER {
+				continue
+			}
+			s := asn1String(tc.b)
+			if ReadASN1(&s, &x) || !bytes.Equal(s, tc.b) {
+				t.Fatalf("ReadASN1(%x): expected failure", tc.b)
+			}
+		}
+	})
+}
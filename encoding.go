@@ -0,0 +1,234 @@
+package fixed
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// JSONFormat describes how an integer is encoded as JSON.
+type JSONFormat uint8
+
+const (
+	// JSONString encodes integers as quoted base 10 strings,
+	// like "1234".
+	//
+	// Most JSON decoders parse numbers as IEEE 754 doubles,
+	// so this is the default.
+	JSONString JSONFormat = iota
+	// JSONNumber encodes integers as bare JSON numbers, like
+	// 1234.
+	JSONNumber
+	// JSONHex encodes integers as quoted hexadecimal
+	// quantities, like "0x4d2". See [FormatHexQuantity].
+	JSONHex
+)
+
+func (f JSONFormat) String() string {
+	switch f {
+	case JSONString:
+		return "JSONString"
+	case JSONNumber:
+		return "JSONNumber"
+	case JSONHex:
+		return "JSONHex"
+	default:
+		return "JSONFormat(" + strconv.Itoa(int(f)) + ")"
+	}
+}
+
+// JSON wraps an integer to encode it with a specific
+// [JSONFormat].
+//
+// For example, the following encodes Balance as a bare JSON
+// number:
+//
+//	type Account struct {
+//		Balance fixed.JSON[fixed.Uint256] `json:"balance"`
+//	}
+//
+//	acct := Account{
+//		Balance: fixed.JSON[fixed.Uint256]{
+//			Value:  x,
+//			Format: fixed.JSONNumber,
+//		},
+//	}
+//
+// Decoding accepts every format regardless of Format.
+type JSON[T Uint[T]] struct {
+	Value  T
+	Format JSONFormat
+}
+
+var (
+	_ json.Marshaler   = JSON[Uint256]{}
+	_ json.Unmarshaler = (*JSON[Uint256])(nil)
+)
+
+// MarshalJSON implements [json.Marshaler].
+func (v JSON[T]) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, v.Value, v.Format)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (v *JSON[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&v.Value, data)
+}
+
+// appendJSON appends the JSON encoding of x to dst.
+func appendJSON[T Uint[T]](dst []byte, x T, format JSONFormat) ([]byte, error) {
+	switch format {
+	case JSONString:
+		dst = append(dst, '"')
+		dst = append(dst, x.String()...)
+		return append(dst, '"'), nil
+	case JSONNumber:
+		return append(dst, x.String()...), nil
+	case JSONHex:
+		dst = append(dst, '"')
+		dst = appendHexQuantity(dst, x)
+		return append(dst, '"'), nil
+	default:
+		return nil, errors.New("fixed: invalid JSON format: " + format.String())
+	}
+}
+
+// unmarshalJSON sets x to the JSON number or string in data.
+//
+// Like encoding/json, unmarshalJSON leaves x unchanged if data
+// is null.
+func unmarshalJSON[T Uint[T]](x *T, data []byte) error {
+	const fnUnmarshalJSON = "UnmarshalJSON"
+
+	s := string(data)
+	switch {
+	case s == "null":
+		return nil
+	case len(s) > 0 && s[0] == '"':
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return unmarshalText(x, []byte(s))
+	default:
+		// Only plain base 10 integers are valid JSON numbers.
+		for i := 0; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return syntaxError(fnUnmarshalJSON, s)
+			}
+		}
+		v, _, _, err := parseUint[T](s, 10, false)
+		if err != nil {
+			err.(*strconv.NumError).Func = fnUnmarshalJSON
+			return err
+		}
+		*x = v
+		return nil
+	}
+}
+
+// unmarshalText sets x to the base 10 or "0x"-prefixed base 16
+// integer in text.
+func unmarshalText[T Uint[T]](x *T, text []byte) error {
+	const fnUnmarshalText = "UnmarshalText"
+
+	s := string(text)
+	base := 10
+	if len(s) >= 2 && s[0] == '0' && lower(s[1]) == 'x' {
+		base = 16
+		s = s[2:]
+	}
+	v, _, _, err := parseUint[T](s, base, false)
+	if err != nil {
+		err.(*strconv.NumError).Func = fnUnmarshalText
+		err.(*strconv.NumError).Num = cloneString(string(text))
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// FormatHexQuantity returns x as a hexadecimal quantity.
+//
+// A quantity is the "0x"-prefixed, lowercase base 16
+// representation of x without leading zeros, as used by
+// Ethereum JSON-RPC. Zero is "0x0".
+func FormatHexQuantity[T Uint[T]](x T) string {
+	return string(appendHexQuantity(nil, x))
+}
+
+// appendHexQuantity appends the hexadecimal quantity x to dst.
+func appendHexQuantity[T Uint[T]](dst []byte, x T) []byte {
+	return appendText(append(dst, "0x"...), x, 16)
+}
+
+// ParseHexQuantity parses a hexadecimal quantity.
+//
+// Unlike [ParseUint256] and friends, ParseHexQuantity requires
+// the lowercase "0x" prefix and at least one digit, and rejects
+// leading zeros other than the quantity "0x0". Errors have type
+// [*strconv.NumError].
+func ParseHexQuantity[T Uint[T]](s string) (T, error) {
+	const fnParseHexQuantity = "ParseHexQuantity"
+
+	if len(s) < 3 || s[0] != '0' || s[1] != 'x' ||
+		len(s) > 3 && s[2] == '0' {
+		return *new(T), syntaxError(fnParseHexQuantity, s)
+	}
+	x, _, _, err := parseUint[T](s[2:], 16, false)
+	if err != nil {
+		err.(*strconv.NumError).Func = fnParseHexQuantity
+		err.(*strconv.NumError).Num = cloneString(s)
+		return x, err
+	}
+	return x, nil
+}
+
+// isLittleEndian reports whether order is little endian.
+func isLittleEndian(order binary.AppendByteOrder) bool {
+	switch order {
+	case binary.LittleEndian:
+		return true
+	case binary.BigEndian:
+		return false
+	default:
+		var b [2]byte
+		return order.AppendUint16(b[:0], 1)[0] == 1
+	}
+}
+
+// fillBytes sets buf to the big-endian integer b, zero-extended
+// on the left, and returns buf.
+//
+// fillBytes panics if b does not fit in buf.
+func fillBytes(buf, b []byte) []byte {
+	for i := range buf {
+		buf[i] = 0
+	}
+	if len(buf) >= len(b) {
+		copy(buf[len(buf)-len(b):], b)
+		return buf
+	}
+	n := len(b) - len(buf)
+	for _, c := range b[:n] {
+		if c != 0 {
+			panic("fixed: buffer too small to fit value")
+		}
+	}
+	copy(buf, b[n:])
+	return buf
+}
+
+// truncBytes returns the low-order n bytes of the big-endian
+// integer b and reports whether any of the discarded bytes were
+// non-zero.
+func truncBytes(b []byte, n int) ([]byte, bool) {
+	if len(b) <= n {
+		return b, false
+	}
+	overflow := false
+	for _, c := range b[:len(b)-n] {
+		overflow = overflow || c != 0
+	}
+	return b[len(b)-n:], overflow
+}
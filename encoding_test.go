@@ -0,0 +1,351 @@
+package fixed
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type marshaler[T any] interface {
+	Uint[T]
+	encoding.TextMarshaler
+	encoding.BinaryMarshaler
+	json.Marshaler
+}
+
+type unmarshaler[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+	encoding.BinaryUnmarshaler
+	json.Unmarshaler
+}
+
+func TestMarshal(t *testing.T) {
+	testMarshal[Uint96](t)
+	testMarshal[Uint128](t)
+	testMarshal[Uint192](t)
+	testMarshal[Uint256](t)
+	testMarshal[Uint512](t)
+	testMarshal[Uint1024](t)
+	testMarshal[Uint2048](t)
+}
+
+func testMarshal[T marshaler[T], P unmarshaler[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			want := randUint[T]()
+			v := toBig(t, want)
+
+			text, err := want.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != v.String() {
+				t.Fatalf("MarshalText: expected %q, got %q", v, text)
+			}
+
+			for _, s := range []string{
+				v.String(),
+				"0x" + v.Text(16),
+				"0X" + v.Text(16),
+			} {
+				var got T
+				if err := P(&got).UnmarshalText([]byte(s)); err != nil {
+					t.Fatalf("UnmarshalText(%q): %v", s, err)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("UnmarshalText(%q): expected %s, got %s", s, want, got)
+				}
+			}
+
+			data, err := want.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got T
+			if err := P(&got).UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(want) {
+				t.Fatalf("UnmarshalBinary: expected %s, got %s", want, got)
+			}
+
+			data, err = json.Marshal(want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := `"` + v.String() + `"`; string(data) != s {
+				t.Fatalf("MarshalJSON: expected %s, got %s", s, data)
+			}
+			for _, s := range []string{
+				v.String(),
+				`"` + v.String() + `"`,
+				`"0x` + v.Text(16) + `"`,
+			} {
+				var got T
+				if err := json.Unmarshal([]byte(s), &got); err != nil {
+					t.Fatalf("UnmarshalJSON(%s): %v", s, err)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("UnmarshalJSON(%s): expected %s, got %s", s, want, got)
+				}
+			}
+
+			for _, tc := range []struct {
+				format JSONFormat
+				want   string
+			}{
+				{JSONString, `{"x":"` + v.String() + `"}`},
+				{JSONNumber, `{"x":` + v.String() + `}`},
+				{JSONHex, `{"x":"0x` + v.Text(16) + `"}`},
+			} {
+				type wrapper struct {
+					X JSON[T] `json:"x"`
+				}
+				data, err := json.Marshal(wrapper{JSON[T]{want, tc.format}})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(data) != tc.want {
+					t.Fatalf("%s: expected %s, got %s", tc.format, tc.want, data)
+				}
+				var got wrapper
+				if err := json.Unmarshal(data, &got); err != nil {
+					t.Fatal(err)
+				}
+				if !got.X.Value.Equal(want) {
+					t.Fatalf("%s: expected %s, got %s", tc.format, want, got.X.Value)
+				}
+			}
+		}
+
+		// null is a no-op.
+		want := randUint[T]()
+		got := want
+		if err := json.Unmarshal([]byte("null"), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("null: expected %s, got %s", want, got)
+		}
+
+		max := new(big.Int).Lsh(big.NewInt(1), uint(want.Size()))
+		for _, s := range []string{
+			"",
+			"0x",
+			"-1",
+			"+1",
+			"1.5",
+			"1e3",
+			"0b101",
+			"abc",
+			max.String(),
+			"0x" + max.Text(16),
+		} {
+			var x T
+			if err := P(&x).UnmarshalText([]byte(s)); err == nil {
+				t.Fatalf("UnmarshalText(%q): expected an error", s)
+			}
+		}
+		for _, s := range []string{
+			`-1`,
+			`1.5`,
+			`1e3`,
+			`0x10`,
+			`true`,
+			`""`,
+			`"1.5"`,
+			`"abc"`,
+			max.String(),
+		} {
+			var x T
+			if err := json.Unmarshal([]byte(s), &x); err == nil {
+				t.Fatalf("UnmarshalJSON(%s): expected an error", s)
+			}
+		}
+		var x T
+		if err := P(&x).UnmarshalBinary(make([]byte, x.Size()/8+1)); err == nil {
+			t.Fatal("UnmarshalBinary: expected an error")
+		}
+	})
+}
+
+func TestHexQuantity(t *testing.T) {
+	testHexQuantity[Uint96](t)
+	testHexQuantity[Uint128](t)
+	testHexQuantity[Uint192](t)
+	testHexQuantity[Uint256](t)
+	testHexQuantity[Uint512](t)
+	testHexQuantity[Uint1024](t)
+	testHexQuantity[Uint2048](t)
+}
+
+func testHexQuantity[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		xs := []T{zero, zero.Add64(1), zero.Add64(0x400), zero.max()}
+		for i := 0; i < 1000; i++ {
+			xs = append(xs, randUint[T]().Rsh(uint(rand.Intn(zero.Size()))))
+		}
+		for _, x := range xs {
+			v := toBig(t, x)
+			want := "0x" + v.Text(16)
+			s := FormatHexQuantity(x)
+			if s != want {
+				t.Fatalf("FormatHexQuantity(%s): expected %q, got %q", x, want, s)
+			}
+			for _, s := range []string{want, "0x" + strings.ToUpper(v.Text(16))} {
+				got, err := ParseHexQuantity[T](s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !got.Equal(x) {
+					t.Fatalf("ParseHexQuantity(%q): expected %s, got %s", s, x, got)
+				}
+			}
+		}
+
+		max := new(big.Int).Lsh(big.NewInt(1), uint(zero.Size()))
+		for _, tc := range []struct {
+			s   string
+			err error
+		}{
+			{"", strconv.ErrSyntax},
+			{"0", strconv.ErrSyntax},
+			{"0x", strconv.ErrSyntax},
+			{"0X1", strconv.ErrSyntax},
+			{"0x00", strconv.ErrSyntax},
+			{"0x01", strconv.ErrSyntax},
+			{"1", strconv.ErrSyntax},
+			{"x1", strconv.ErrSyntax},
+			{"400", strconv.ErrSyntax},
+			{"0b1", strconv.ErrSyntax},
+			{"0xg", strconv.ErrSyntax},
+			{"0x-1", strconv.ErrSyntax},
+			{" 0x1", strconv.ErrSyntax},
+			{"0x" + max.Text(16), strconv.ErrRange},
+		} {
+			_, err := ParseHexQuantity[T](tc.s)
+			var nerr *strconv.NumError
+			if !errors.As(err, &nerr) || nerr.Func != "ParseHexQuantity" || nerr.Num != tc.s || !errors.Is(err, tc.err) {
+				t.Fatalf("ParseHexQuantity(%q): expected %v, got %v", tc.s, tc.err, err)
+			}
+		}
+	})
+}
+
+func TestBytes(t *testing.T) {
+	testBytes[Uint96](t)
+	testBytes[Uint128](t)
+	testBytes[Uint192](t)
+	testBytes[Uint256](t)
+	testBytes[Uint512](t)
+	testBytes[Uint1024](t)
+	testBytes[Uint2048](t)
+}
+
+func testBytes[T interface {
+	Uint[T]
+	AppendBytes([]byte, binary.AppendByteOrder) []byte
+	FillBytes([]byte) []byte
+}, P interface {
+	*T
+	SetBytes([]byte) error
+	SetBigEndianBytes([]byte) error
+	SetBytesPadded([]byte) error
+	SetBytesTrunc([]byte) bool
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size() / 8
+		for i := 0; i < 1000; i++ {
+			want := randUint[T]().Rsh(uint(rand.Intn(zero.Size())))
+			v := toBig(t, want)
+			be := v.FillBytes(make([]byte, size))
+			le := make([]byte, size)
+			for i, c := range be {
+				le[size-1-i] = c
+			}
+
+			if got := want.AppendBytes([]byte("x"), binary.BigEndian); !bytes.Equal(got, append([]byte("x"), be...)) {
+				t.Fatalf("AppendBytes(BigEndian): expected %x, got %x", be, got[1:])
+			}
+			if got := want.AppendBytes(nil, binary.LittleEndian); !bytes.Equal(got, le) {
+				t.Fatalf("AppendBytes(LittleEndian): expected %x, got %x", le, got)
+			}
+
+			var got T
+			if err := P(&got).SetBigEndianBytes(be); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(want) {
+				t.Fatalf("SetBigEndianBytes: expected %s, got %s", want, got)
+			}
+			if err := P(&got).SetBytes(le); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(want) {
+				t.Fatalf("SetBytes: expected %s, got %s", want, got)
+			}
+
+			// The minimal big-endian encoding.
+			short := v.Bytes()
+			if err := P(&got).SetBytesPadded(short); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(want) {
+				t.Fatalf("SetBytesPadded: expected %s, got %s", want, got)
+			}
+			if P(&got).SetBytesTrunc(short) || !got.Equal(want) {
+				t.Fatalf("SetBytesTrunc: expected %s, got %s", want, got)
+			}
+
+			// Longer inputs are truncated.
+			long := append([]byte{0, 0}, be...)
+			if P(&got).SetBytesTrunc(long) || !got.Equal(want) {
+				t.Fatalf("SetBytesTrunc(%x): expected %s, got %s", long, want, got)
+			}
+			long[1] = 1
+			if !P(&got).SetBytesTrunc(long) || !got.Equal(want) {
+				t.Fatalf("SetBytesTrunc(%x): expected (%s, true), got %s", long, want, got)
+			}
+			if err := P(&got).SetBytesPadded(long); !errors.Is(err, ErrOverflow) {
+				t.Fatalf("SetBytesPadded(%x): expected %v, got %v", long, ErrOverflow, err)
+			}
+
+			for _, n := range []int{len(short), size, size + 7} {
+				buf := make([]byte, n)
+				for i := range buf {
+					buf[i] = 0xff
+				}
+				if got, want := want.FillBytes(buf), v.FillBytes(make([]byte, n)); !bytes.Equal(got, want) {
+					t.Fatalf("FillBytes(%d): expected %x, got %x", n, want, got)
+				}
+			}
+			if len(short) > 0 {
+				func() {
+					defer func() {
+						if recover() == nil {
+							t.Fatalf("FillBytes(%d): expected a panic", len(short)-1)
+						}
+					}()
+					want.FillBytes(make([]byte, len(short)-1))
+				}()
+			}
+		}
+
+		var x T
+		if err := P(&x).SetBigEndianBytes(make([]byte, size-1)); err == nil {
+			t.Fatal("SetBigEndianBytes: expected an error")
+		}
+	})
+}
@@ -1,7 +1,29 @@
 // Package fixed implements fixed-size numeric types.
+//
+// # Databases
+//
+// The integer types implement [fmt.Scanner], whose Scan method
+// conflicts with [database/sql.Scanner], so no integer type can
+// be used directly as a scan destination. Wrap it with
+// [SQLScanner] instead, or use [Null] for nullable columns:
+//
+//	var x fixed.Uint256
+//	err := row.Scan(fixed.SQLScanner(&x))
 package fixed
 
+import "errors"
+
 //go:generate go run github.com/ericlagergren/fixed/internal/cmd/gen 256 512 1024 2048
+//go:generate go run github.com/ericlagergren/fixed/internal/cmd/gen -signed 96 128 192 256 512 1024 2048
+
+var (
+	// ErrOverflow is returned when the result of an operation
+	// cannot be represented by its type.
+	ErrOverflow = errors.New("fixed: overflow")
+	// ErrDivisionByZero is returned when the divisor of an
+	// operation is zero.
+	ErrDivisionByZero = errors.New("fixed: division by zero")
+)
 
 // Uint is an unsigned integer.
 type Uint[T any] interface {
@@ -29,11 +51,49 @@ type Uint[T any] interface {
 	Sub(T) T
 	// Mul returns x*y.
 	Mul(T) T
+	// MulCheck returns x*y and reports false if the
+	// multiplication overflowed.
+	MulCheck(T) (T, bool)
 	// QuoRem returns (q, r) such that
 	//
 	//	q = x/y
 	//	r = x - y*q
 	QuoRem(T) (q, r T)
+	// QuoRemCheck is like QuoRem, but returns
+	// ErrDivisionByZero instead of panicking if y == 0.
+	QuoRemCheck(T) (q, r T, err error)
+	// SaturatingAdd returns x+y, or the maximum value of T if
+	// x+y overflows.
+	SaturatingAdd(T) T
+	// SaturatingSub returns x-y, or zero if x-y underflows.
+	SaturatingSub(T) T
+	// SaturatingMul returns x*y, or the maximum value of T if
+	// x*y overflows.
+	SaturatingMul(T) T
+	// SaturatingLsh returns x<<n, or the maximum value of T if
+	// any set bits are shifted out.
+	SaturatingLsh(uint) T
+	// Quo returns x/y, rounded toward zero.
+	Quo(T) T
+	// Rem returns x%y.
+	Rem(T) T
+	// QuoCeil returns x/y, rounded up.
+	QuoCeil(T) T
+	// QuoRound returns x/y, rounded according to mode.
+	QuoRound(T, RoundingMode) T
+	// MulDiv returns x*y/z, rounded toward zero, and reports
+	// whether the result fits in T.
+	MulDiv(y, z T) (T, bool)
+	// MulDivRoundUp returns x*y/z, rounded up, and reports
+	// whether the result fits in T.
+	MulDivRoundUp(y, z T) (T, bool)
+	// MulDivRound returns x*y/z, rounded according to mode, and
+	// reports whether the result fits in T.
+	MulDivRound(y, z T, mode RoundingMode) (T, bool)
+	// Exp returns x^y mod m.
+	//
+	// If m == 0, Exp returns x^y.
+	Exp(y, m T) T
 	// And returns x&y.
 	And(T) T
 	// Or returns x|y.
@@ -46,17 +106,109 @@ type Uint[T any] interface {
 	Rsh(uint) T
 	// String returns the base-10 representation of x.
 	String() string
+	// GoString returns x as a call to the constructor that
+	// produces x.
+	GoString() string
+
+	// Add64 returns x+y.
+	Add64(uint64) T
+	// AddCheck64 returns x+y and a carry of 1 if x+y
+	// overflows, or 0 otherwise.
+	AddCheck64(uint64) (T, uint64)
+	// Sub64 returns x-y.
+	Sub64(uint64) T
+	// SubCheck64 returns x-y and a borrow of 1 if x-y
+	// underflows, or 0 otherwise.
+	SubCheck64(uint64) (T, uint64)
+	// Mul64 returns x*y.
+	Mul64(uint64) T
+	// MulCheck64 returns x*y and reports false if the
+	// multiplication overflowed.
+	MulCheck64(uint64) (T, bool)
+	// QuoRem64 returns (q, r) such that
+	//
+	//	q = x/y
+	//	r = x - y*q
+	QuoRem64(uint64) (q T, r uint64)
+	// Cmp64 compares x and y and returns
+	//
+	//   - +1 if x > y
+	//   - 0 if x == y
+	//   - -1 if x < y
+	Cmp64(uint64) int
 
 	orLsh64(y uint64, s uint) T
 	uint8() uint8
-	mulCheck64(uint64) (T, bool)
-	addCheck64(uint64) (T, uint64)
-	add64(uint64) T
-	mul64(uint64) T
-	cmp64(uint64) int
+	uint64() uint64
+	mulPow10(uint) (T, bool)
 	max() T
 }
 
+// Int is a signed, two's complement integer.
+type Int[T any] interface {
+	// Size returns the width of the integer in bits.
+	Size() int
+	// BitLen returns the number of bits required to represent
+	// |x|.
+	BitLen() int
+	// IsZero repors whether x is zero.
+	IsZero() bool
+	// Sign returns
+	//
+	//   - -1 if x < 0
+	//   - 0 if x == 0
+	//   - +1 if x > 0
+	Sign() int
+	// Cmp compares x and y and returns
+	//
+	//   - +1 if x > y
+	//   - 0 if x == y
+	//   - -1 if x < y
+	Cmp(T) int
+	// Equal reports whether x == y.
+	//
+	// In general, prefer the == operator to using this method.
+	Equal(T) bool
+	// Neg returns -x.
+	Neg() T
+	// Abs returns |x|.
+	Abs() T
+	// Add returns x+y.
+	Add(T) T
+	// Sub returns x-y.
+	Sub(T) T
+	// Mul returns x*y.
+	Mul(T) T
+	// QuoRem returns (q, r) such that
+	//
+	//	q = x/y
+	//	r = x - y*q
+	//
+	// with q truncated toward zero.
+	QuoRem(T) (q, r T)
+	// DivMod returns (q, m) such that
+	//
+	//	q = x div y
+	//	m = x - y*q
+	//
+	// with 0 <= m < |y|.
+	DivMod(T) (q, m T)
+	// And returns x&y.
+	And(T) T
+	// Or returns x|y.
+	Or(T) T
+	// Xor returns x^y.
+	Xor(T) T
+	// Not returns ^x.
+	Not() T
+	// Lsh returns x<<n.
+	Lsh(uint) T
+	// Rsh returns x>>n, copying the sign bit.
+	Rsh(uint) T
+	// String returns the base-10 representation of x.
+	String() string
+}
+
 func cmp(x, y uint64) int {
 	switch {
 	case x > y:
@@ -1,6 +1,8 @@
 package fixed
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -13,6 +15,19 @@ func bigMask(n uint) *big.Int {
 	return v.Sub(v, big.NewInt(1))          // 1<<n - 1
 }
 
+// bigSigned interprets v as an n-bit two's complement integer.
+func bigSigned(v *big.Int, n uint) *big.Int {
+	if v.Bit(int(n-1)) != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), n))
+	}
+	return v
+}
+
+// bigWrap truncates v to an n-bit two's complement integer.
+func bigWrap(v *big.Int, n uint) *big.Int {
+	return bigSigned(v.And(v, bigMask(n)), n)
+}
+
 func randBool() bool {
 	return rand.Intn(2) == 0
 }
@@ -21,6 +36,30 @@ func randUint64() uint64 {
 	return rand.Uint64()
 }
 
+// randUint returns a random T.
+func randUint[T Uint[T]]() T {
+	var x T
+	switch p := any(&x).(type) {
+	case *Uint96:
+		*p = randUint96()
+	case *Uint128:
+		*p = randUint128()
+	case *Uint192:
+		*p = randUint192()
+	case *Uint256:
+		*p = randUint256()
+	case *Uint512:
+		*p = randUint512()
+	case *Uint1024:
+		*p = randUint1024()
+	case *Uint2048:
+		*p = randUint2048()
+	default:
+		panic(fmt.Sprintf("unknown type: %T", x))
+	}
+	return x
+}
+
 var sink struct {
 	string   string
 	uint64   uint64
@@ -33,6 +72,238 @@ var sink struct {
 	Uint2048 Uint2048
 }
 
+// toBig returns x as a [big.Int].
+func toBig[T Uint[T]](t *testing.T, x T) *big.Int {
+	t.Helper()
+
+	v, ok := new(big.Int).SetString(x.String(), 10)
+	if !ok {
+		t.Fatalf("invalid integer: %s", x)
+	}
+	return v
+}
+
+// testMulPow10 checks x.mulPow10 against math/big.
+func testMulPow10[T Uint[T]](t *testing.T, rnd func() T) {
+	t.Helper()
+
+	var zero T
+	size := zero.Size()
+
+	// 10^0 is 1, not 0.
+	for i := 0; i < 100; i++ {
+		x := rnd()
+		if got, ok := x.mulPow10(0); !ok || !got.Equal(x) {
+			t.Fatalf("%s * 10^0: expected (%s, true), got (%s, %t)", x, x, got, ok)
+		}
+	}
+
+	for i := 0; i < 10_000; i++ {
+		x := rnd().Rsh(uint(rand.Intn(size)))
+		n := uint(rand.Intn(size/3 + 2))
+
+		want := toBig(t, x)
+		want.Mul(want, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+		wantOK := want.BitLen() <= size
+
+		got, gotOK := x.mulPow10(n)
+		if gotOK != wantOK {
+			t.Fatalf("%s * 10^%d: expected %t, got %t", x, n, wantOK, gotOK)
+		}
+		if gotOK && got.String() != want.String() {
+			t.Fatalf("%s * 10^%d: expected %d, got %s", x, n, want, got)
+		}
+	}
+}
+
+func TestMulCheckBound(t *testing.T) {
+	testMulCheckBound[Uint96](t)
+	testMulCheckBound[Uint128](t)
+	testMulCheckBound[Uint192](t)
+	testMulCheckBound[Uint256](t)
+	testMulCheckBound[Uint512](t)
+	testMulCheckBound[Uint1024](t)
+	testMulCheckBound[Uint2048](t)
+}
+
+// testMulCheckBound tests MulCheck with operands whose bit
+// lengths sum to N+1, where the product may or may not fit.
+func testMulCheckBound[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size()
+		one := zero.Add64(1)
+		for a := 1; a < size; a++ {
+			// 2^a * (2^(N-a) - 1) = 2^N - 2^a fits.
+			x := one.Lsh(uint(a))
+			y := one.Lsh(uint(size - a)).Sub64(1)
+			if z, ok := x.MulCheck(y); !ok || !z.Equal(zero.Sub(x)) {
+				t.Fatalf("%s * %s: expected (%s, true), got (%s, %t)",
+					x, y, zero.Sub(x), z, ok)
+			}
+			// 2^a * 2^(N-a) = 2^N does not.
+			if _, ok := x.MulCheck(y.Add64(1)); ok {
+				t.Fatalf("%s * %s: expected an overflow", x, y.Add64(1))
+			}
+		}
+
+		max := new(big.Int).Lsh(big.NewInt(1), uint(size))
+		for i := 0; i < 10_000; i++ {
+			a := 1 + rand.Intn(size)
+			x := randBits[T](a)
+			y := randBits[T](size + 1 - a)
+			want := new(big.Int).Mul(toBig(t, x), toBig(t, y))
+			z, ok := x.MulCheck(y)
+			if wantOK := want.Cmp(max) < 0; ok != wantOK {
+				t.Fatalf("%s * %s: expected %t, got %t", x, y, wantOK, ok)
+			}
+			if ok && toBig(t, z).Cmp(want) != 0 {
+				t.Fatalf("%s * %s: expected %s, got %s", x, y, want, z)
+			}
+		}
+	})
+}
+
+// randBits returns a random T with a bit length of exactly n.
+func randBits[T Uint[T]](n int) T {
+	var x T
+	for s := 0; s < n; s += 64 {
+		x = x.orLsh64(rand.Uint64(), uint(s))
+	}
+	x = x.Lsh(uint(x.Size() - n)).Rsh(uint(x.Size() - n))
+	return x.Or((*new(T)).Add64(1).Lsh(uint(n - 1)))
+}
+
+// testMulDiv checks x.MulDiv, x.MulDivRoundUp, and
+// x.MulDivRound against math/big.
+func testMulDiv[T Uint[T]](t *testing.T, rnd func() T) {
+	t.Helper()
+
+	var zero T
+	size := uint(zero.Size())
+	for i := 0; i < 10_000; i++ {
+		x := rnd()
+		y := rnd()
+		z := rnd().Rsh(uint(rand.Intn(int(size))))
+		if z.IsZero() {
+			z = zero.Add64(1)
+		}
+		mode := roundingModes[rand.Intn(len(roundingModes))]
+
+		num := new(big.Int).Mul(toBig(t, x), toBig(t, y))
+		for _, tc := range []struct {
+			name string
+			fn   func() (T, bool)
+			mode RoundingMode
+		}{
+			{"MulDiv", func() (T, bool) { return x.MulDiv(y, z) }, ToZero},
+			{"MulDivRoundUp", func() (T, bool) { return x.MulDivRoundUp(y, z) }, AwayFromZero},
+			{"MulDivRound", func() (T, bool) { return x.MulDivRound(y, z, mode) }, mode},
+		} {
+			want := bigRound(num, toBig(t, z), tc.mode)
+			wantOK := want.BitLen() <= int(size)
+			got, ok := tc.fn()
+			if ok != wantOK {
+				t.Fatalf("%s(%s, %s, %s, %s): expected %t, got %t",
+					tc.name, x, y, z, tc.mode, wantOK, ok)
+			}
+			if ok && toBig(t, got).Cmp(want) != 0 {
+				t.Fatalf("%s(%s, %s, %s, %s): expected %d, got %s",
+					tc.name, x, y, z, tc.mode, want, got)
+			}
+		}
+	}
+}
+
+// testQuo checks x.Quo, x.Rem, x.QuoCeil, x.QuoRound, and
+// x.QuoRemCheck against math/big.
+func testQuo[T Uint[T]](t *testing.T, rnd func() T) {
+	t.Helper()
+
+	var zero T
+	if _, _, err := rnd().QuoRemCheck(zero); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected %v, got %v", ErrDivisionByZero, err)
+	}
+
+	size := zero.Size()
+	for i := 0; i < 10_000; i++ {
+		x := rnd()
+		y := rnd().Rsh(uint(rand.Intn(size)))
+		if y.IsZero() {
+			y = zero.Add64(1)
+		}
+		mode := roundingModes[rand.Intn(len(roundingModes))]
+
+		bx, by := toBig(t, x), toBig(t, y)
+		for _, tc := range []struct {
+			name string
+			got  T
+			want *big.Int
+		}{
+			{"Quo", x.Quo(y), bigRound(bx, by, ToZero)},
+			{"Rem", x.Rem(y), new(big.Int).Rem(bx, by)},
+			{"QuoCeil", x.QuoCeil(y), bigRound(bx, by, ToPositiveInf)},
+			{"QuoRound", x.QuoRound(y, mode), bigRound(bx, by, mode)},
+		} {
+			if toBig(t, tc.got).Cmp(tc.want) != 0 {
+				t.Fatalf("%s(%s, %s, %s): expected %d, got %s",
+					tc.name, x, y, mode, tc.want, tc.got)
+			}
+		}
+
+		q, r, err := x.QuoRemCheck(y)
+		if err != nil {
+			t.Fatalf("QuoRemCheck(%s, %s): unexpected error: %v", x, y, err)
+		}
+		if wq, wr := x.QuoRem(y); !q.Equal(wq) || !r.Equal(wr) {
+			t.Fatalf("QuoRemCheck(%s, %s): expected (%s, %s), got (%s, %s)",
+				x, y, wq, wr, q, r)
+		}
+	}
+}
+
+// testSaturating checks x.SaturatingAdd, x.SaturatingSub,
+// x.SaturatingMul, and x.SaturatingLsh against math/big.
+func testSaturating[T Uint[T]](t *testing.T, rnd func() T) {
+	t.Helper()
+
+	var zero T
+	size := uint(zero.Size())
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), size), big.NewInt(1))
+	clamp := func(v *big.Int) *big.Int {
+		switch {
+		case v.Sign() < 0:
+			return new(big.Int)
+		case v.Cmp(max) > 0:
+			return max
+		default:
+			return v
+		}
+	}
+	for i := 0; i < 10_000; i++ {
+		x := rnd().Rsh(uint(rand.Intn(int(size))))
+		y := rnd().Rsh(uint(rand.Intn(int(size))))
+		n := uint(rand.Intn(int(size) + 2))
+		bx, by := toBig(t, x), toBig(t, y)
+
+		for _, tc := range []struct {
+			name string
+			got  T
+			want *big.Int
+		}{
+			{"SaturatingAdd", x.SaturatingAdd(y), new(big.Int).Add(bx, by)},
+			{"SaturatingSub", x.SaturatingSub(y), new(big.Int).Sub(bx, by)},
+			{"SaturatingMul", x.SaturatingMul(y), new(big.Int).Mul(bx, by)},
+			{"SaturatingLsh", x.SaturatingLsh(n), new(big.Int).Lsh(bx, n)},
+		} {
+			if want := clamp(tc.want); toBig(t, tc.got).Cmp(want) != 0 {
+				t.Fatalf("%s(%s, %s, %d): expected %d, got %s",
+					tc.name, x, y, n, want, tc.got)
+			}
+		}
+	}
+}
+
 func TestInlining(t *testing.T) {
 	testutil.TestInlining(t, "github.com/ericlagergren/fixed",
 		"ParseUint1024",
@@ -60,61 +331,64 @@ func TestInlining(t *testing.T) {
 		"Uint1024.LeadingZeros",
 		"Uint1024.Size",
 		"Uint128.Add",
+		"Uint128.Add64",
 		"Uint128.AddCheck",
+		"Uint128.AddCheck64",
 		"Uint128.And",
 		"Uint128.BitLen",
 		"Uint128.Bytes",
 		"Uint128.Cmp",
+		"Uint128.Cmp64",
 		"Uint128.Equal",
 		"Uint128.GoString",
 		"Uint128.IsZero",
 		"Uint128.LeadingZeros",
 		"Uint128.Lsh",
 		"Uint128.Mul",
+		"Uint128.Mul64",
+		"Uint128.MulCheck64",
 		"Uint128.Or",
 		"Uint128.Rsh",
 		"Uint128.Size",
 		"Uint128.Sub",
+		"Uint128.Sub64",
 		"Uint128.SubCheck",
 		"Uint128.Xor",
-		"Uint128.add64",
-		"Uint128.addCheck64",
-		"Uint128.cmp64",
 		"Uint128.max",
-		"Uint128.mul64",
-		"Uint128.mulCheck64",
-		"Uint128.sub64",
 		"Uint128.uint192",
 		"Uint192.Add",
+		"Uint192.Add64",
 		"Uint192.AddCheck",
+		"Uint192.AddCheck64",
 		"Uint192.And",
 		"Uint192.BitLen",
 		"Uint192.Cmp",
+		"Uint192.Cmp64",
 		"Uint192.Equal",
 		"Uint192.GoString",
 		"Uint192.IsZero",
 		"Uint192.LeadingZeros",
+		"Uint192.Mul64",
 		"Uint192.Or",
 		"Uint192.Size",
 		"Uint192.Sub",
 		"Uint192.SubCheck",
 		"Uint192.Xor",
-		"Uint192.add64",
-		"Uint192.addCheck64",
-		"Uint192.cmp64",
 		"Uint192.hi128",
 		"Uint192.low128",
 		"Uint192.max",
-		"Uint192.mul64",
 		"Uint2048.Equal",
 		"Uint2048.IsZero",
 		"Uint2048.LeadingZeros",
 		"Uint2048.Size",
 		"Uint256.Add",
+		"Uint256.Add64",
 		"Uint256.AddCheck",
+		"Uint256.AddCheck64",
 		"Uint256.And",
 		"Uint256.BitLen",
 		"Uint256.Bytes",
+		"Uint256.Cmp64",
 		"Uint256.Equal",
 		"Uint256.GoString",
 		"Uint256.IsZero",
@@ -122,13 +396,10 @@ func TestInlining(t *testing.T) {
 		"Uint256.Or",
 		"Uint256.Size",
 		"Uint256.Sub",
+		"Uint256.Sub64",
 		"Uint256.SubCheck",
 		"Uint256.Xor",
-		"Uint256.add64",
-		"Uint256.addCheck64",
-		"Uint256.cmp64",
 		"Uint256.max",
-		"Uint256.sub64",
 		"Uint512.And",
 		"Uint512.Bytes",
 		"Uint512.Equal",
@@ -138,17 +409,22 @@ func TestInlining(t *testing.T) {
 		"Uint512.Size",
 		"Uint512.Xor",
 		"Uint96.Add",
-		"Uint96.Bytes",
+		"Uint96.Add64",
 		"Uint96.AddCheck",
+		"Uint96.AddCheck64",
 		"Uint96.And",
 		"Uint96.BitLen",
+		"Uint96.Bytes",
 		"Uint96.Cmp",
+		"Uint96.Cmp64",
 		"Uint96.Equal",
 		"Uint96.GoString",
 		"Uint96.IsZero",
 		"Uint96.LeadingZeros",
 		"Uint96.Lsh",
 		"Uint96.Mul",
+		"Uint96.Mul64",
+		"Uint96.MulCheck64",
 		"Uint96.Or",
 		"Uint96.Rsh",
 		"Uint96.Size",
@@ -156,12 +432,7 @@ func TestInlining(t *testing.T) {
 		"Uint96.Sub",
 		"Uint96.SubCheck",
 		"Uint96.Xor",
-		"Uint96.add64",
-		"Uint96.addCheck64",
-		"Uint96.cmp64",
 		"Uint96.max",
-		"Uint96.mul64",
-		"Uint96.mulCheck64",
 		"cloneString",
 		"digits",
 		"lower",
@@ -0,0 +1,127 @@
+package fixed
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+var (
+	errNaN      = errors.New("fixed: cannot convert NaN")
+	errNegative = errors.New("fixed: cannot convert negative value")
+)
+
+// toFloat64 returns the float64 nearest to x, with ties rounded
+// to even, and the accuracy of the result.
+func toFloat64[T Uint[T]](x T) (float64, big.Accuracy) {
+	mant, exp, acc := roundFloat(x, 53)
+	if exp+bits.Len64(mant) > 1024 {
+		return math.Inf(1), big.Above
+	}
+	return math.Ldexp(float64(mant), exp), acc
+}
+
+// toFloat32 returns the float32 nearest to x, with ties rounded
+// to even, and the accuracy of the result.
+func toFloat32[T Uint[T]](x T) (float32, big.Accuracy) {
+	mant, exp, acc := roundFloat(x, 24)
+	if exp+bits.Len64(mant) > 128 {
+		return float32(math.Inf(1)), big.Above
+	}
+	// mant has at most 24 bits and the exponent is in range, so
+	// the conversion is exact.
+	return float32(math.Ldexp(float64(mant), exp)), acc
+}
+
+// roundFloat rounds x to prec significant bits, with ties
+// rounded to even, and returns (mant, exp, acc) such that the
+// rounded value is mant * 2^exp.
+//
+// prec must be in [1, 63].
+func roundFloat[T Uint[T]](x T, prec int) (mant uint64, exp int, acc big.Accuracy) {
+	n := x.BitLen()
+	if n <= prec {
+		return x.uint64(), 0, big.Exact
+	}
+
+	// Normalize the top 64 bits of x so that the most
+	// significant bit is bit 63. sticky records whether any
+	// bits below those were set.
+	var top uint64
+	sticky := false
+	if n <= 64 {
+		top = x.uint64() << uint(64-n)
+	} else {
+		shift := uint(n - 64)
+		top = x.Rsh(shift).uint64()
+		sticky = !x.Lsh(uint(x.Size()) - shift).IsZero()
+	}
+
+	exp = n - prec
+	mant = top >> uint(64-prec)
+	rem := top << uint(prec) // the discarded bits, left aligned
+	const half = 1 << 63
+	switch {
+	case rem == 0 && !sticky:
+		return mant, exp, big.Exact
+	case rem > half, rem == half && (sticky || mant&1 != 0):
+		mant++
+		if mant == 1<<uint(prec) {
+			mant >>= 1
+			exp++
+		}
+		return mant, exp, big.Above
+	default:
+		return mant, exp, big.Below
+	}
+}
+
+// fromFloat64 returns f rounded to an integer according to
+// mode.
+func fromFloat64[T Uint[T]](f float64, mode RoundingMode) (T, error) {
+	var zero T
+	switch {
+	case math.IsNaN(f):
+		return zero, errNaN
+	case f < 0:
+		return zero, errNegative
+	case math.IsInf(f, 1):
+		return zero, ErrOverflow
+	case f == 0:
+		return zero, nil
+	}
+
+	// f = mant * 2^exp where mant has 53 significant bits.
+	frac, exp := math.Frexp(f)
+	mant := uint64(math.Ldexp(frac, 53))
+	exp -= 53
+
+	if exp >= 0 {
+		if bits.Len64(mant)+exp > zero.Size() {
+			return zero, ErrOverflow
+		}
+		return zero.Add64(mant).Lsh(uint(exp)), nil
+	}
+
+	// Split f into its integer part q and fractional part,
+	// then round q.
+	var q uint64
+	half := -1 // the fraction is less than 1/2
+	exact := false
+	if s := uint(-exp); s < 64 {
+		q = mant >> s
+		r := mant & (1<<s - 1)
+		exact = r == 0
+		switch h := uint64(1) << (s - 1); {
+		case r > h:
+			half = 1
+		case r == h:
+			half = 0
+		}
+	}
+	if mode.inc(half, exact, q&1 != 0, false) {
+		q++
+	}
+	return zero.Add64(q), nil
+}
@@ -0,0 +1,113 @@
+package fixed
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestFloat(t *testing.T) {
+	testFloat[Uint96](t, U96FromFloat64)
+	testFloat[Uint128](t, U128FromFloat64)
+	testFloat[Uint192](t, U192FromFloat64)
+	testFloat[Uint256](t, U256FromFloat64)
+	testFloat[Uint512](t, U512FromFloat64)
+	testFloat[Uint1024](t, U1024FromFloat64)
+	testFloat[Uint2048](t, U2048FromFloat64)
+}
+
+func testFloat[T interface {
+	Uint[T]
+	Float64() (float64, big.Accuracy)
+	Float32() (float32, big.Accuracy)
+}, P interface {
+	*T
+	SetBigFloat(*big.Float, RoundingMode) bool
+}](t *testing.T, from func(float64, RoundingMode) (T, error)) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size()
+		one := zero.Add64(1)
+
+		xs := []T{zero, one, zero.max()}
+		for _, n := range []int{24, 25, 53, 54, 64, 65, 128, 1024, size - 1} {
+			if n < size {
+				p := one.Lsh(uint(n))
+				xs = append(xs, p, p.Sub64(1), p.Add64(1))
+			}
+		}
+		for i := 0; i < 1000; i++ {
+			xs = append(xs, randUint[T]().Rsh(uint(rand.Intn(size))))
+		}
+		for _, x := range xs {
+			v := toBig(t, x)
+			f := new(big.Float).SetInt(v)
+
+			want64, wantAcc := f.Float64()
+			got64, acc := x.Float64()
+			if got64 != want64 || acc != wantAcc {
+				t.Fatalf("Float64(%s): expected (%g, %s), got (%g, %s)",
+					x, want64, wantAcc, got64, acc)
+			}
+			want32, wantAcc := f.Float32()
+			got32, acc := x.Float32()
+			if got32 != want32 || acc != wantAcc {
+				t.Fatalf("Float32(%s): expected (%g, %s), got (%g, %s)",
+					x, want32, wantAcc, got32, acc)
+			}
+		}
+
+		modes := []RoundingMode{
+			ToNearestEven,
+			ToNearestAway,
+			ToNearestZero,
+			ToZero,
+			AwayFromZero,
+			ToNegativeInf,
+			ToPositiveInf,
+		}
+		fs := []float64{
+			0, 0.25, 0.5, 0.75, 1, 1.5, 2.5, 3.5,
+			math.SmallestNonzeroFloat64,
+			1<<53 - 0.5, 1 << 53, 1 << 63, 1 << 64,
+			math.Ldexp(1, size-1),
+			math.Ldexp(1, size),
+			math.MaxFloat64,
+		}
+		for i := 0; i < 1000; i++ {
+			fs = append(fs, math.Ldexp(rand.Float64(), rand.Intn(size+10)))
+		}
+		for _, f := range fs {
+			for _, mode := range modes {
+				var want T
+				overflow := P(&want).SetBigFloat(big.NewFloat(f), mode)
+				got, err := from(f, mode)
+				if overflow {
+					if !errors.Is(err, ErrOverflow) {
+						t.Fatalf("FromFloat64(%g, %s): expected %v, got %v", f, mode, ErrOverflow, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("FromFloat64(%g, %s): %v", f, mode, err)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("FromFloat64(%g, %s): expected %s, got %s", f, mode, want, got)
+				}
+			}
+		}
+
+		if got, err := from(math.Copysign(0, -1), ToNearestEven); err != nil || !got.IsZero() {
+			t.Fatalf("FromFloat64(-0): expected (0, nil), got (%s, %v)", got, err)
+		}
+		for _, f := range []float64{math.NaN(), -1, -0.25, math.Inf(-1), math.Inf(1)} {
+			if _, err := from(f, ToZero); err == nil {
+				t.Fatalf("FromFloat64(%g): expected an error", f)
+			}
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package fixed
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+const textDigits = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// appendText appends the digits of x in the given base to dst
+// and returns the extended slice.
+//
+// It panics if base is not in [2, 62].
+func appendText[T Uint[T]](dst []byte, x T, base int) []byte {
+	if base < 2 || base > len(textDigits) {
+		panic("fixed: invalid base " + strconv.Itoa(base))
+	}
+	if x.IsZero() {
+		return append(dst, '0')
+	}
+
+	// Append the digits least significant first, then reverse
+	// them in place.
+	start := len(dst)
+	b := uint64(base)
+	if base&(base-1) == 0 {
+		// Power of two bases can extract digits directly. Each
+		// chunk holds the largest multiple of the digit size
+		// that fits in a uint64.
+		shift := uint(bits.TrailingZeros64(b))
+		mask := b - 1
+		ndigits := 64 / shift
+		for x.BitLen() > int(ndigits*shift) {
+			w := x.uint64()
+			for j := uint(0); j < ndigits; j++ {
+				dst = append(dst, textDigits[w&mask])
+				w >>= shift
+			}
+			x = x.Rsh(ndigits * shift)
+		}
+		for w := x.uint64(); w != 0; w >>= shift {
+			dst = append(dst, textDigits[w&mask])
+		}
+	} else {
+		// bb is the largest power of base that fits in
+		// a uint64 and ndigits is the number of digits in that
+		// power.
+		bb, ndigits := b, 1
+		for bb <= math.MaxUint64/b {
+			bb *= b
+			ndigits++
+		}
+		for x.Cmp64(bb) >= 0 {
+			q, r := x.QuoRem64(bb)
+			for j := 0; j < ndigits; j++ {
+				dst = append(dst, textDigits[r%b])
+				r /= b
+			}
+			x = q
+		}
+		for r := x.uint64(); r != 0; r /= b {
+			dst = append(dst, textDigits[r%b])
+		}
+	}
+
+	digits := dst[start:]
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return dst
+}
+
+// format implements fmt.Formatter for x.
+//
+// It mirrors (*big.Int).Format.
+func format[T Uint[T]](x T, s fmt.State, ch rune) {
+	// determine base
+	var base int
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o', 'O':
+		base = 8
+	case 'd', 's', 'v':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	default:
+		// unknown format
+		fmt.Fprintf(s, "%%!%c(fixed.Uint%d=%s)", ch, x.Size(), x.String())
+		return
+	}
+
+	if ch == 'v' && s.Flag('#') {
+		fmt.Fprint(s, x.GoString())
+		return
+	}
+
+	// determine sign character
+	sign := ""
+	switch {
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	// determine prefix characters for indicating output base
+	prefix := ""
+	if s.Flag('#') {
+		switch ch {
+		case 'b': // binary
+			prefix = "0b"
+		case 'o': // octal
+			prefix = "0"
+		case 'x': // hexadecimal
+			prefix = "0x"
+		case 'X':
+			prefix = "0X"
+		}
+	}
+	if ch == 'O' {
+		prefix = "0o"
+	}
+
+	digits := appendText(nil, x, base)
+	if ch == 'X' {
+		for i, d := range digits {
+			if 'a' <= d && d <= 'z' {
+				digits[i] = 'A' + (d - 'a')
+			}
+		}
+	}
+
+	// number of characters for the three classes of number
+	// padding
+	var left int  // space characters to left of digits for right justification ("%8d")
+	var zeros int // zero characters as left-most digits ("%.8d")
+	var right int // space characters to right of digits for left justification ("%-8d")
+
+	// determine number padding from precision: the least
+	// number of digits to output
+	precision, precisionSet := s.Precision()
+	if precisionSet {
+		switch {
+		case len(digits) < precision:
+			zeros = precision - len(digits) // count of zero padding
+		case len(digits) == 1 && digits[0] == '0' && precision == 0:
+			return // print nothing if zero value (x == 0) and zero precision ("." or ".0")
+		}
+	}
+
+	// determine field pad from width: the least number of
+	// characters to output
+	length := len(sign) + len(prefix) + zeros + len(digits)
+	if width, widthSet := s.Width(); widthSet && length < width { // pad as specified
+		switch d := width - length; {
+		case s.Flag('-'):
+			// pad on the right with spaces; supersedes '0'
+			// when both are set
+			right = d
+		case s.Flag('0') && !precisionSet:
+			// pad with zeros unless precision also set
+			zeros = d
+		default:
+			// pad on the left with spaces
+			left = d
+		}
+	}
+
+	// print number as [left pad][sign][prefix][zero pad][digits][right pad]
+	writeMultiple(s, " ", left)
+	writeMultiple(s, sign, 1)
+	writeMultiple(s, prefix, 1)
+	writeMultiple(s, "0", zeros)
+	s.Write(digits)
+	writeMultiple(s, " ", right)
+}
+
+// writeMultiple writes n copies of text to s.
+func writeMultiple(s fmt.State, text string, n int) {
+	if len(text) > 0 {
+		b := []byte(text)
+		for ; n > 0; n-- {
+			s.Write(b)
+		}
+	}
+}
+
+// scan implements fmt.Scanner for x.
+//
+// Like package fmt, the 'v' and 's' verbs accept the base
+// prefixes "0b", "0o", "0x", and "0" while the other verbs only
+// accept digits.
+func scan[T Uint[T]](x *T, s fmt.ScanState, ch rune) error {
+	const fnScan = "Scan"
+
+	var base int
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o':
+		base = 8
+	case 'd':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	case 's', 'v':
+		// let parseUint determine the base
+	default:
+		return errors.New("fixed: Scan: invalid verb " + strconv.QuoteRune(ch))
+	}
+
+	s.SkipSpace()
+	tok, err := s.Token(false, func(r rune) bool {
+		switch {
+		case '0' <= r && r <= '9':
+			return base == 0 || int(r-'0') < base
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+			return base == 0 || base == 16 && lower(byte(r)) <= 'f'
+		default:
+			return false
+		}
+	})
+	if err != nil {
+		return err
+	}
+	v, _, _, err := parseUint[T](string(tok), base, false)
+	if err != nil {
+		err.(*strconv.NumError).Func = fnScan
+		return err
+	}
+	*x = v
+	return nil
+}
@@ -0,0 +1,385 @@
+package fixed
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+var formats = []string{
+	"%b",
+	"%o",
+	"%O",
+	"%d",
+	"%s",
+	"%v",
+	"%x",
+	"%X",
+	"%#b",
+	"%#o",
+	"%#O",
+	"%#x",
+	"%#X",
+	"%+d",
+	"% d",
+	"%+x",
+	"%50d",
+	"%-50d|",
+	"%050d",
+	"%050x",
+	"%#050x",
+	"%-#50X|",
+	"%.60d",
+	"%.60x",
+	"%70.60x",
+	"%-70.60o|",
+	"%070.60b",
+	"%.0d",
+	"%.d",
+	"%5.0x",
+}
+
+func TestFormat(t *testing.T) {
+	testFormat[Uint96](t)
+	testFormat[Uint128](t)
+	testFormat[Uint192](t)
+	testFormat[Uint256](t)
+	testFormat[Uint512](t)
+	testFormat[Uint1024](t)
+	testFormat[Uint2048](t)
+}
+
+func testFormat[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		for i := 0; i < 500; i++ {
+			x := randUint[T]()
+			if i == 0 {
+				x = zero
+			}
+			v := toBig(t, x)
+			for _, f := range formats {
+				want := fmt.Sprintf(f, v)
+				got := fmt.Sprintf(f, x)
+				if got != want {
+					t.Fatalf("%q: expected %q, got %q", f, want, got)
+				}
+			}
+		}
+
+		want := fmt.Sprintf("%%!q(fixed.Uint%d=0)", zero.Size())
+		if got := fmt.Sprintf("%q", zero); got != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+	})
+}
+
+func TestGoString(t *testing.T) {
+	for _, tc := range []struct {
+		x    any
+		want string
+	}{
+		{U96(1, 2), "fixed.U96(0x1, 0x2)"},
+		{U128(0, 0xff), "fixed.U128(0x0, 0xff)"},
+		{U192(1, 2, 3), "fixed.U192(0x1, 0x2, 0x3)"},
+		{U256(1, 2, 3, 0xdeadbeef), "fixed.U256(0x1, 0x2, 0x3, 0xdeadbeef)"},
+		{U512From64(42), "fixed.U512(0x2a, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0)"},
+	} {
+		if got := fmt.Sprintf("%#v", tc.x); got != tc.want {
+			t.Fatalf("expected %q, got %q", tc.want, got)
+		}
+		if got := tc.x.(fmt.GoStringer).GoString(); got != tc.want {
+			t.Fatalf("expected %q, got %q", tc.want, got)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	testScan[Uint96](t)
+	testScan[Uint128](t)
+	testScan[Uint192](t)
+	testScan[Uint256](t)
+	testScan[Uint512](t)
+	testScan[Uint1024](t)
+	testScan[Uint2048](t)
+}
+
+func testScan[T Uint[T], P interface {
+	*T
+	fmt.Scanner
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		for i := 0; i < 500; i++ {
+			want := randUint[T]()
+			for _, tc := range []struct {
+				format string
+				verb   string
+			}{
+				{"%b", "%b"},
+				{"%o", "%o"},
+				{"%d", "%d"},
+				{"%x", "%x"},
+				{"%X", "%X"},
+				{"%X", "%x"},
+				{"%d", "%v"},
+				{"%d", "%s"},
+				{"%#b", "%v"},
+				{"%O", "%v"},
+				{"%#o", "%v"},
+				{"%#x", "%v"},
+				{"%#X", "%v"},
+			} {
+				s := fmt.Sprintf(tc.format, want)
+				var got T
+				if _, err := fmt.Sscanf(" "+s, tc.verb, P(&got)); err != nil {
+					t.Fatalf("Sscanf(%q, %q): %v", s, tc.verb, err)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("Sscanf(%q, %q): expected %s, got %s", s, tc.verb, want, got)
+				}
+			}
+		}
+
+		var x, y T
+		n, err := fmt.Sscan("12 0x34", P(&x), P(&y))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 2 || x.String() != "12" || y.String() != "52" {
+			t.Fatalf("Sscan: expected (2, 12, 52), got (%d, %s, %s)", n, x, y)
+		}
+
+		// Scanning stops at the first invalid digit.
+		var rest string
+		if _, err := fmt.Sscanf("1019z", "%b%s", P(&x), &rest); err != nil {
+			t.Fatal(err)
+		}
+		if x.String() != "5" || rest != "9z" {
+			t.Fatalf("expected (5, %q), got (%s, %q)", "9z", x, rest)
+		}
+
+		max := new(big.Int).Lsh(big.NewInt(1), uint(x.Size()))
+		for _, tc := range []struct {
+			s, verb string
+			err     error
+		}{
+			{max.String(), "%d", strconv.ErrRange},
+			{"0x" + max.Text(16), "%v", strconv.ErrRange},
+			{"0xz", "%v", strconv.ErrSyntax},
+			{"09", "%v", strconv.ErrSyntax},
+			{"z", "%d", strconv.ErrSyntax},
+		} {
+			_, err := fmt.Sscanf(tc.s, tc.verb, P(&x))
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Sscanf(%q, %q): expected %v, got %v", tc.s, tc.verb, tc.err, err)
+			}
+			var nerr *strconv.NumError
+			if !errors.As(err, &nerr) || nerr.Func != "Scan" {
+				t.Fatalf("Sscanf(%q, %q): expected *strconv.NumError, got %#v", tc.s, tc.verb, err)
+			}
+		}
+		if _, err := fmt.Sscanf("1", "%q", P(&x)); err == nil {
+			t.Fatal("expected an error for an invalid verb")
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	testText[Uint96](t)
+	testText[Uint128](t)
+	testText[Uint192](t)
+	testText[Uint256](t)
+	testText[Uint512](t)
+	testText[Uint1024](t)
+	testText[Uint2048](t)
+}
+
+func testText[T interface {
+	Uint[T]
+	Text(int) string
+	Append([]byte, int) []byte
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		for i := 0; i < 100; i++ {
+			x := randUint[T]().Rsh(uint(rand.Intn(zero.Size())))
+			if i == 0 {
+				x = zero
+			}
+			v := toBig(t, x)
+			for base := 2; base <= 62; base++ {
+				want := v.Text(base)
+				if got := x.Text(base); got != want {
+					t.Fatalf("%s.Text(%d): expected %q, got %q", x, base, want, got)
+				}
+				if got := string(x.Append([]byte("prefix"), base)); got != "prefix"+want {
+					t.Fatalf("%s.Append(%d): expected %q, got %q", x, base, "prefix"+want, got)
+				}
+				if base > 36 {
+					continue
+				}
+				got, _, _, err := parseUint[T](want, base, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !got.Equal(x) {
+					t.Fatalf("%q in base %d: expected %s, got %s", want, base, x, got)
+				}
+			}
+		}
+
+		for _, base := range []int{-1, 0, 1, 63} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Fatalf("Text(%d): expected a panic", base)
+					}
+				}()
+				zero.Text(base)
+			}()
+		}
+	})
+}
+
+func BenchmarkUint256Text(b *testing.B) {
+	x := U256(math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64)
+	for _, base := range []int{10, 16, 36} {
+		b.Run(strconv.Itoa(base), func(b *testing.B) {
+			b.ReportAllocs()
+			buf := make([]byte, 0, 256)
+			for i := 0; i < b.N; i++ {
+				buf = x.Append(buf[:0], base)
+			}
+		})
+	}
+}
+
+func TestAppendDecimal(t *testing.T) {
+	testAppendDecimal[Uint96](t)
+	testAppendDecimal[Uint128](t)
+	testAppendDecimal[Uint192](t)
+	testAppendDecimal[Uint256](t)
+	testAppendDecimal[Uint512](t)
+	testAppendDecimal[Uint1024](t)
+	testAppendDecimal[Uint2048](t)
+}
+
+func testAppendDecimal[T interface {
+	Uint[T]
+	Text(int) string
+	AppendText([]byte) ([]byte, error)
+	AppendDecimal([]byte) []byte
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		buf := make([]byte, 0, 1024)
+		for i := 0; i < 1000; i++ {
+			x := randUint[T]().Rsh(uint(rand.Intn(zero.Size())))
+			if i == 0 {
+				x = zero
+			}
+			want := x.Text(10)
+			if got := x.String(); got != want {
+				t.Fatalf("String: expected %q, got %q", want, got)
+			}
+			if got := string(x.AppendDecimal(buf[:0])); got != want {
+				t.Fatalf("AppendDecimal: expected %q, got %q", want, got)
+			}
+			got, err := x.AppendText([]byte("x="))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != "x="+want {
+				t.Fatalf("AppendText: expected %q, got %q", "x="+want, got)
+			}
+		}
+
+		x := zero.max()
+		if n := testing.AllocsPerRun(100, func() {
+			buf = x.AppendDecimal(buf[:0])
+		}); n != 0 {
+			t.Fatalf("AppendDecimal: expected 0 allocs, got %f", n)
+		}
+		if n := testing.AllocsPerRun(100, func() {
+			buf, _ = x.AppendText(buf[:0])
+		}); n != 0 {
+			t.Fatalf("AppendText: expected 0 allocs, got %f", n)
+		}
+	})
+}
+
+func TestFormatInto(t *testing.T) {
+	// check reports whether got holds want and is a suffix of b.
+	check := func(b, got []byte, want string) {
+		t.Helper()
+
+		if string(got) != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+		if &got[len(got)-1] != &b[len(b)-1] {
+			t.Fatalf("%s: expected a suffix of b", want)
+		}
+	}
+	var b96 [29]byte
+	for _, x := range []Uint96{{}, U96From64(42), U96From64(math.MaxUint64), Uint96{}.max()} {
+		check(b96[:], x.FormatInto(&b96), x.Text(10))
+	}
+	var b128 [39]byte
+	for _, x := range []Uint128{{}, U128From64(42), U128From64(math.MaxUint64), Uint128{}.max()} {
+		check(b128[:], x.FormatInto(&b128), x.Text(10))
+	}
+	var b192 [58]byte
+	for _, x := range []Uint192{{}, U192From64(42), Uint192{}.max()} {
+		check(b192[:], x.FormatInto(&b192), x.Text(10))
+	}
+
+	x := U256(math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64)
+	var b [78]byte
+	check(b[:], x.FormatInto(&b), x.Text(10))
+	check(b[:], U256From64(42).FormatInto(&b), "42")
+	if n := testing.AllocsPerRun(100, func() {
+		x.FormatInto(&b)
+	}); n != 0 {
+		t.Fatalf("expected 0 allocs, got %f", n)
+	}
+}
+
+func BenchmarkAppendDecimal(b *testing.B) {
+	b.Run("Uint96", func(b *testing.B) { benchmarkAppendDecimal(b, Uint96{}.max()) })
+	b.Run("Uint128", func(b *testing.B) { benchmarkAppendDecimal(b, Uint128{}.max()) })
+	b.Run("Uint192", func(b *testing.B) { benchmarkAppendDecimal(b, Uint192{}.max()) })
+	b.Run("Uint256", func(b *testing.B) { benchmarkAppendDecimal(b, Uint256{}.max()) })
+	b.Run("Uint512", func(b *testing.B) { benchmarkAppendDecimal(b, Uint512{}.max()) })
+	b.Run("Uint1024", func(b *testing.B) { benchmarkAppendDecimal(b, Uint1024{}.max()) })
+	b.Run("Uint2048", func(b *testing.B) { benchmarkAppendDecimal(b, Uint2048{}.max()) })
+}
+
+func benchmarkAppendDecimal[T interface {
+	Uint[T]
+	AppendDecimal([]byte) []byte
+}](b *testing.B, x T) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 1024)
+	for i := 0; i < b.N; i++ {
+		buf = x.AppendDecimal(buf[:0])
+	}
+	if n := testing.AllocsPerRun(10, func() {
+		buf = x.AppendDecimal(buf[:0])
+	}); n != 0 {
+		b.Fatalf("expected 0 allocs, got %f", n)
+	}
+}
+
+func BenchmarkUint256FormatInto(b *testing.B) {
+	b.ReportAllocs()
+	x := Uint256{}.max()
+	var buf [78]byte
+	for i := 0; i < b.N; i++ {
+		x.FormatInto(&buf)
+	}
+}
@@ -0,0 +1,507 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// signWord returns the expression for the word in a Uint that
+// holds the sign bit and the shift that isolates it.
+func signWord(bits int) (string, int) {
+	if bits%64 != 0 {
+		return fmt.Sprintf("x.u.u%d", bits/64), 31
+	}
+	return fmt.Sprintf("x.u.u%d", bits/64-1), 63
+}
+
+func genInt(b *bytes.Buffer, bits int) {
+	word, shift := signWord(bits)
+	p := func(format string, args ...any) {
+		args = append(args,
+			named("name", fmt.Sprintf("Int%d", bits)),
+			named("uname", fmt.Sprintf("Uint%d", bits)),
+			named("bits", bits),
+			named("word", word),
+			named("shift", shift),
+		)
+		fprintf(b, format, args...)
+	}
+
+	p(`// Code generated by 'gen'. DO NOT EDIT.
+
+package fixed
+
+// {:name} is a signed, {:bits}-bit integer.
+//
+// It is stored in two's complement form and can be compared
+// for equality with ==.
+type {:name} struct {
+	u {:uname}
+}
+
+var _ Int[{:name}] = {:name}{}
+
+// I{:bits}From64 constructs an [{:name}] from an int64.
+func I{:bits}From64(x int64) {:name} {
+	if x < 0 {
+		return {:name}{U{:bits}From64(uint64(-x))}.Neg()
+	}
+	return {:name}{U{:bits}From64(uint64(x))}
+}
+
+// I{:bits}FromUint constructs an [{:name}] from the two's
+// complement representation x.
+func I{:bits}FromUint(x {:uname}) {:name} {
+	return {:name}{x}
+}
+
+// Uint returns the two's complement representation of x.
+func (x {:name}) Uint() {:uname} {
+	return x.u
+}
+
+func ({:name}) max() {:name} {
+	return {:name}{ {:uname}{}.max().Rsh(1) }
+}
+
+func ({:name}) min() {:name} {
+	return {:name}{}.max().Not()
+}
+
+// isNeg reports whether x < 0.
+func (x {:name}) isNeg() bool {
+	return {:word}>>{:shift} != 0
+}
+
+// abs returns |x| as an unsigned integer.
+//
+// Unlike Abs, abs is correct for the minimum value.
+func (x {:name}) abs() {:uname} {
+	if x.isNeg() {
+		return {:uname}{}.Sub(x.u)
+	}
+	return x.u
+}
+
+// Size returns the width of the integer in bits.
+func ({:name}) Size() int {
+	return {:bits}
+}
+
+// BitLen returns the number of bits required to represent |x|.
+func (x {:name}) BitLen() int {
+	return x.abs().BitLen()
+}
+
+// IsZero is shorthand for x == {:name}{}.
+func (x {:name}) IsZero() bool {
+	return x == {:name}{}
+}
+
+// Sign returns
+//
+//   - -1 if x < 0
+//   - 0 if x == 0
+//   - +1 if x > 0
+func (x {:name}) Sign() int {
+	switch {
+	case x.isNeg():
+		return -1
+	case x.IsZero():
+		return 0
+	default:
+		return +1
+	}
+}
+
+// Cmp compares x and y and returns
+//
+//   - +1 if x > y
+//   - 0 if x == y
+//   - -1 if x < y
+func (x {:name}) Cmp(y {:name}) int {
+	switch xn, yn := x.isNeg(), y.isNeg(); {
+	case xn && !yn:
+		return -1
+	case !xn && yn:
+		return +1
+	default:
+		return x.u.Cmp(y.u)
+	}
+}
+
+// Equal reports whether x == y.
+//
+// In general, prefer the == operator to using this method.
+func (x {:name}) Equal(y {:name}) bool {
+	return x == y
+}
+
+// Neg returns -x.
+//
+// Like Go's built-in integers, the negation of the minimum
+// value is the minimum value.
+func (x {:name}) Neg() {:name} {
+	return {:name}{ {:uname}{}.Sub(x.u) }
+}
+
+// Abs returns |x|.
+//
+// Like Neg, the absolute value of the minimum value is the
+// minimum value.
+func (x {:name}) Abs() {:name} {
+	if x.isNeg() {
+		return x.Neg()
+	}
+	return x
+}
+
+// And returns x&y.
+func (x {:name}) And(y {:name}) {:name} {
+	return {:name}{x.u.And(y.u)}
+}
+
+// Or returns x|y.
+func (x {:name}) Or(y {:name}) {:name} {
+	return {:name}{x.u.Or(y.u)}
+}
+
+// Xor returns x^y.
+func (x {:name}) Xor(y {:name}) {:name} {
+	return {:name}{x.u.Xor(y.u)}
+}
+
+// Not returns ^x.
+func (x {:name}) Not() {:name} {
+	return {:name}{x.u.Xor({:uname}{}.max())}
+}
+
+// Lsh returns x<<n.
+func (x {:name}) Lsh(n uint) {:name} {
+	return {:name}{x.u.Lsh(n)}
+}
+
+// Rsh returns x>>n.
+//
+// The shift is arithmetic: the sign bit of x is shifted into
+// the vacated bits.
+func (x {:name}) Rsh(n uint) {:name} {
+	if x.isNeg() {
+		return {:name}{x.Not().u.Rsh(n)}.Not()
+	}
+	return {:name}{x.u.Rsh(n)}
+}
+
+// Add returns x+y.
+func (x {:name}) Add(y {:name}) {:name} {
+	return {:name}{x.u.Add(y.u)}
+}
+
+// Sub returns x-y.
+func (x {:name}) Sub(y {:name}) {:name} {
+	return {:name}{x.u.Sub(y.u)}
+}
+
+// Mul returns x*y.
+func (x {:name}) Mul(y {:name}) {:name} {
+	return {:name}{x.u.Mul(y.u)}
+}
+
+// QuoRem returns (q, r) such that
+//
+//	q = x/y
+//	r = x - y*q
+//
+// QuoRem implements truncated division (like Go); see DivMod
+// for Euclidean division.
+func (x {:name}) QuoRem(y {:name}) (q, r {:name}) {
+	uq, ur := x.abs().QuoRem(y.abs())
+	q, r = {:name}{uq}, {:name}{ur}
+	if x.isNeg() != y.isNeg() {
+		q = q.Neg()
+	}
+	if x.isNeg() {
+		r = r.Neg()
+	}
+	return q, r
+}
+
+// DivMod returns (q, m) such that
+//
+//	q = x div y
+//	m = x - y*q
+//
+// DivMod implements Euclidean division (unlike Go), so m is
+// always in the range [0, |y|); see QuoRem for truncated
+// division.
+func (x {:name}) DivMod(y {:name}) (q, m {:name}) {
+	q, m = x.QuoRem(y)
+	if m.isNeg() {
+		if y.isNeg() {
+			q = q.Add(I{:bits}From64(1)) // q++
+			m = m.Sub(y)                 // m -= y
+		} else {
+			q = q.Sub(I{:bits}From64(1)) // q--
+			m = m.Add(y)                 // m += y
+		}
+	}
+	return q, m
+}
+
+// String returns the base-10 representation of x.
+func (x {:name}) String() string {
+	if x.isNeg() {
+		return "-" + x.abs().String()
+	}
+	return x.u.String()
+}
+
+// ParseInt{:bits} returns the value of s in the given base.
+func ParseInt{:bits}(s string, base int) ({:name}, error) {
+	x, err := parseInt[{:uname}](s, base)
+	return {:name}{x}, err
+}
+`)
+}
+
+func genIntTest(b *bytes.Buffer, bits int) {
+	p := func(format string, args ...any) {
+		args = append(args,
+			named("name", fmt.Sprintf("Int%d", bits)),
+			named("uname", fmt.Sprintf("Uint%d", bits)),
+			named("bits", bits),
+		)
+		fprintf(b, format, args...)
+	}
+
+	p(`// Code generated by 'gen'. DO NOT EDIT.
+
+package fixed
+
+import (
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func rand{:name}() {:name} {
+	return I{:bits}FromUint(rand{:uname}())
+}
+
+func (x {:name}) big() *big.Int {
+	return bigSigned(x.u.big(), {:bits})
+}
+
+func Test{:name}From64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		v := int64(rand.Uint64())
+		got := I{:bits}From64(v).big()
+		want := big.NewInt(v)
+		if got.Cmp(want) != 0 {
+			t.Fatalf("expected %%d, got %%d", want, got)
+		}
+	}
+}
+
+func Test{:name}Sign(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+
+		got := x.Sign()
+		want := x.big().Sign()
+		if got != want {
+			t.Fatalf("Sign(%%d): expected %%d, got %%d", x.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}Cmp(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+
+		got := x.Cmp(y)
+		want := x.big().Cmp(y.big())
+		if got != want {
+			t.Fatalf("Cmp(%%d, %%d): expected %%d, got %%d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}Neg(t *testing.T) {
+	test := func(x {:name}) {
+		want := bigWrap(new(big.Int).Neg(x.big()), {:bits})
+		if got := x.Neg().big(); got.Cmp(want) != 0 {
+			t.Fatalf("-%%d: expected %%d, got %%d", x.big(), want, got)
+		}
+		want = bigWrap(new(big.Int).Abs(x.big()), {:bits})
+		if got := x.Abs().big(); got.Cmp(want) != 0 {
+			t.Fatalf("|%%d|: expected %%d, got %%d", x.big(), want, got)
+		}
+	}
+	test({:name}{}.min())
+	test({:name}{}.max())
+	for i := 0; i < 100_000; i++ {
+		test(rand{:name}())
+	}
+}
+
+func Test{:name}Add(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+
+		want := bigWrap(new(big.Int).Add(x.big(), y.big()), {:bits})
+		if got := x.Add(y).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d + %%d: expected %%d, got %%d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}Sub(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+
+		want := bigWrap(new(big.Int).Sub(x.big(), y.big()), {:bits})
+		if got := x.Sub(y).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d - %%d: expected %%d, got %%d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}Mul(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+
+		want := bigWrap(new(big.Int).Mul(x.big(), y.big()), {:bits})
+		if got := x.Mul(y).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d * %%d: expected %%d, got %%d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}QuoRem(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+		if y.IsZero() {
+			y = I{:bits}From64(-1)
+		}
+
+		q, r := x.QuoRem(y)
+
+		wantq := new(big.Int)
+		wantr := new(big.Int)
+		wantq.QuoRem(x.big(), y.big(), wantr)
+		wantq = bigWrap(wantq, {:bits})
+
+		if got := q.big(); got.Cmp(wantq) != 0 {
+			t.Fatalf("%%d / %%d expected quotient of %%d, got %%d",
+				x.big(), y.big(), wantq, got)
+		}
+		if got := r.big(); got.Cmp(wantr) != 0 {
+			t.Fatalf("%%d / %%d expected remainder of %%d, got %%d",
+				x.big(), y.big(), wantr, got)
+		}
+	}
+}
+
+func Test{:name}DivMod(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+		if y.IsZero() {
+			y = I{:bits}From64(-1)
+		}
+
+		q, m := x.DivMod(y)
+
+		wantq := new(big.Int)
+		wantm := new(big.Int)
+		wantq.DivMod(x.big(), y.big(), wantm)
+		wantq = bigWrap(wantq, {:bits})
+
+		if got := q.big(); got.Cmp(wantq) != 0 {
+			t.Fatalf("%%d div %%d expected quotient of %%d, got %%d",
+				x.big(), y.big(), wantq, got)
+		}
+		if got := m.big(); got.Cmp(wantm) != 0 {
+			t.Fatalf("%%d mod %%d expected modulus of %%d, got %%d",
+				x.big(), y.big(), wantm, got)
+		}
+	}
+}
+
+func Test{:name}Rsh(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		n := uint(rand.Intn({:bits} + 1))
+
+		want := new(big.Int).Rsh(x.big(), n)
+		if got := x.Rsh(n).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d >> %%d: expected %%d, got %%d",
+				x.big(), n, want, got)
+		}
+	}
+}
+
+func Test{:name}String(t *testing.T) {
+	test := func(x {:name}) {
+		want := x.big().String()
+		got := x.String()
+		if want != got {
+			t.Fatalf("expected %%q, got %%q", want, got)
+		}
+	}
+	test({:name}{})      // zero
+	test({:name}{}.min()) // min
+	test({:name}{}.max()) // max
+	for i := 0; i < 10_000; i++ {
+		test(rand{:name}())
+	}
+}
+
+func TestParse{:name}(t *testing.T) {
+	for i := 0; i < 1_000; i++ {
+		want := rand{:name}()
+		b := want.big()
+		for base := 2; base <= 36; base++ {
+			s := b.Text(base)
+			got, err := Parse{:name}(s, base)
+			if err != nil {
+				t.Fatalf("%%q in base %%d: unexpected error: %%v", s, base, err)
+			}
+			if got != want {
+				t.Fatalf("%%q in base %%d: expected %%d, got %%d",
+					s, base, want, got)
+			}
+		}
+	}
+}
+
+func TestParse{:name}Range(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want {:name}
+	}{
+		{new(big.Int).Add({:name}{}.max().big(), big.NewInt(1)).String(), {:name}{}.max()},
+		{new(big.Int).Sub({:name}{}.min().big(), big.NewInt(1)).String(), {:name}{}.min()},
+	} {
+		got, err := Parse{:name}(tc.s, 10)
+		if err == nil {
+			t.Fatalf("%%q: expected an error", tc.s)
+		}
+		if got != tc.want {
+			t.Fatalf("%%q: expected %%d, got %%d", tc.s, tc.want, got)
+		}
+	}
+}
+`)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"io"
@@ -12,29 +13,46 @@ import (
 	"strings"
 )
 
+var signed = flag.Bool("signed", false, "generate signed integers")
+
 func main() {
-	for _, s := range os.Args[1:] {
+	flag.Parse()
+	for _, s := range flag.Args() {
 		if err := main1(s); err != nil {
 			log.Fatal(err)
 		}
 	}
 }
 
+type file struct {
+	path string
+	fn   func(*bytes.Buffer, int)
+}
+
 func main1(s string) error {
 	bits, err := strconv.Atoi(s)
 	if err != nil {
 		return err
 	}
-	if bits < 0 || bits%64 != 0 {
-		return fmt.Errorf("invalid bit size: %d", bits)
+	var files []file
+	if *signed {
+		if bits < 0 || bits%32 != 0 {
+			return fmt.Errorf("invalid bit size: %d", bits)
+		}
+		files = []file{
+			{fmt.Sprintf("int%d.go", bits), genInt},
+			{fmt.Sprintf("int%d_test.go", bits), genIntTest},
+		}
+	} else {
+		if bits < 0 || bits%64 != 0 {
+			return fmt.Errorf("invalid bit size: %d", bits)
+		}
+		files = []file{
+			{fmt.Sprintf("uint%d.go", bits), gen},
+			{fmt.Sprintf("uint%d_test.go", bits), genTest},
+		}
 	}
-	for _, v := range []struct {
-		path string
-		fn   func(*bytes.Buffer, int)
-	}{
-		{fmt.Sprintf("uint%d.go", bits), gen},
-		{fmt.Sprintf("uint%d_test.go", bits), genTest},
-	} {
+	for _, v := range files {
 		var b bytes.Buffer
 		v.fn(&b, bits)
 		src, err := format.Source(b.Bytes())
@@ -107,6 +125,8 @@ func gen(b *bytes.Buffer, bits int) {
 			named("bits", bits),
 			named("halfBits", bits/2),
 			named("halfMask", (bits/2)-1),
+			named("bytes", bits/8),
+			named("strLen", maxStrLen(uint(bits))),
 		)
 		fprintf(b, format, args...)
 	}
@@ -129,9 +149,11 @@ func gen(b *bytes.Buffer, bits int) {
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"sync"
 )
@@ -183,6 +205,15 @@ func U{:bits}From64(x uint64) {:name} {
 	return {:name}{u0: x}
 }
 
+// U{:bits}FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [{:name}].
+func U{:bits}FromFloat64(f float64, mode RoundingMode) ({:name}, error) {
+	return fromFloat64[{:name}](f, mode)
+}
+
 func u{:bits}(lo, hi Uint{:halfBits}) {:name} {
 	return {:name}{`)
 	for _, s := range []string{"lo", "hi"} {
@@ -235,6 +266,29 @@ func (x {:name}) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+func (x {:name}) uint64() uint64 {
+	return x.u0
+}
+
+// limbs returns x as little-endian 64-bit words.
+func (x {:name}) limbs() [%[1]d]uint64 {
+	return [%[1]d]uint64{`, bits/64)
+	for i := 0; i < bits/64; i++ {
+		p("x.u%d,", i)
+	}
+	p(`}
+}
+
+// u{:bits}FromLimbs constructs a [{:name}] from little-endian
+// 64-bit words.
+func u{:bits}FromLimbs(v [%[1]d]uint64) {:name} {
+	return {:name}{`, bits/64)
+	for i := 0; i < bits/64; i++ {
+		p("v[%d],", i)
+	}
+	p(`}
+}
+
 // Bytes encodes x as a little-endian integer.
 func (x {:name}) Bytes(b *[%d]byte) {
 `, (bits+7)/8)
@@ -259,6 +313,212 @@ func (x *{:name}) SetBytes(b []byte) error {
 	p(`return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x {:name}) BigEndianBytes(b *[{:bytes}]byte) {
+`)
+	for i := 0; i < bits/64; i++ {
+		p("binary.BigEndian.PutUint64(b[%d:], x.u%d)\n",
+			i*8, bits/64-1-i)
+	}
+	p(`}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *{:name}) SetBigEndianBytes(b []byte) error {
+	if len(b) != {:bytes} {
+		return fmt.Errorf("fixed: invalid length: %%d", len(b))
+	}
+`)
+	for i := 0; i < bits/64; i++ {
+		p("x.u%d = binary.BigEndian.Uint64(b[%d:])\n",
+			bits/64-1-i, i*8)
+	}
+	p(`return nil
+}
+
+// AppendBytes appends the {:bytes}-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x {:name}) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+`)
+	for i := 0; i < bits/64; i++ {
+		p("dst = order.AppendUint64(dst, x.u%d)\n", i)
+	}
+	p(`return dst
+	}
+`)
+	for i := bits/64 - 1; i >= 0; i-- {
+		p("dst = order.AppendUint64(dst, x.u%d)\n", i)
+	}
+	p(`return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x {:name}) FillBytes(buf []byte) []byte {
+	var b [{:bytes}]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than {:bytes} bytes.
+//
+// It returns [ErrOverflow] if b is longer than {:bytes} bytes.
+func (x *{:name}) SetBytesPadded(b []byte) error {
+	if len(b) > {:bytes} {
+		return ErrOverflow
+	}
+	var be [{:bytes}]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order {:bytes} bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *{:name}) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, {:bytes})
+	_ = x.SetBytesPadded(b) // len(b) <= {:bytes}
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x {:name}) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *{:name}) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [{:name}.Bytes].
+func (x {:name}) MarshalBinary() ([]byte, error) {
+	var b [{:bytes}]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [{:name}.SetBytes].
+func (x *{:name}) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x {:name}) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *{:name}) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x {:name}) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x {:name}) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [{:name}].
+func (x *{:name}) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x {:name}) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x {:name}) FillBig(z *big.Int) *big.Int {
+	v := x.limbs()
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^{:bits} and reports whether v is
+// negative or does not fit in {:bits} bits.
+func (x *{:name}) SetBig(v *big.Int) (overflow bool) {
+`)
+	p("var z [%d]uint64\n", bits/64)
+	p(`overflow = setBig(z[:], v, {:bits})
+	*x = u{:bits}FromLimbs(z)
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x {:name}) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^{:bits}, and reports whether the rounded value is
+// negative or does not fit in {:bits} bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *{:name}) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = {:name}{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x {:name}) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x {:name}) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func ({:name}) Size() int {
 	return {:bits}
@@ -302,12 +562,12 @@ func (x {:name}) Cmp(y {:name}) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x {:name}) cmp64(y uint64) int {
+func (x {:name}) Cmp64(y uint64) int {
 	v := x
 	v.u0 = 0
 	if !v.IsZero() {
@@ -443,8 +703,8 @@ func (x {:name}) Add(y {:name}) {:name} {
 	p(`return z
 }
 
-// add64 returns x+y.
-func (x {:name}) add64(y uint64) {:name} {
+// Add64 returns x+y.
+func (x {:name}) Add64(y uint64) {:name} {
 	var z {:name}
 	var carry uint64
 `)
@@ -468,10 +728,10 @@ func (x {:name}) AddCheck(y {:name}) (z {:name}, carry uint64) {
 	p(`return z, carry
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x {:name}) addCheck64(y uint64) (z {:name}, carry uint64) {
+func (x {:name}) AddCheck64(y uint64) (z {:name}, carry uint64) {
 `)
 	p("z.u0, carry = bits.Add64(x.u0, y, 0)\n")
 	for i := 1; i < bits/64; i++ {
@@ -493,8 +753,8 @@ func (x {:name}) Sub(y {:name}) {:name} {
 	p(`return z
 }
 
-// sub64 returns x-y.
-func (x {:name}) sub64(y uint64) {:name} {
+// Sub64 returns x-y.
+func (x {:name}) Sub64(y uint64) {:name} {
 	var z {:name}
 	var borrow uint64
 `)
@@ -518,10 +778,10 @@ func (x {:name}) SubCheck(y {:name}) (z {:name}, borrow uint64) {
 	p(`return z, borrow
 }
 
-// subCheck64 returns x-y.
+// SubCheck64 returns x-y.
 //
 // borrow is 1 if x-y overflows and 0 otherwise.
-func (x {:name}) subCheck64(y uint64) (z {:name}, borrow uint64) {
+func (x {:name}) SubCheck64(y uint64) (z {:name}, borrow uint64) {
 `)
 	p("z.u0, borrow = bits.Sub64(x.u0, y, 0)\n")
 	for i := 1; i < bits/64; i++ {
@@ -605,7 +865,8 @@ func (x {:name}) mul{:halfBits}(y Uint{:halfBits}) {:name} {
 	p(`return z
 }
 
-func (x {:name}) mul64(y uint64) {:name} {
+// Mul64 returns x*y.
+func (x {:name}) Mul64(y uint64) {:name} {
 	if y == 0 {
 		return {:name}{}
 	}
@@ -620,71 +881,162 @@ func (x {:name}) mul64(y uint64) {:name} {
 	p(`return z
 }
 
-// Exp return x^y mod m.
+`)
+	p(`// MulFull returns the full 2*{:bits}-bit product x*y as
+// (hi, lo).
 //
-// If m == 0, Exp simply returns x^y.
-func (x {:name}) Exp(y, m {:name}) {:name} {
-	const mask = 1 << (64-1)
+// It is the {:bits}-bit analogue of [bits.Mul64].
+func (x {:name}) MulFull(y {:name}) (hi, lo {:name}) {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [2 * %[1]d]uint64
+	for i, d := range yv {
+		if d == 0 {
+			continue
+		}
+		var c uint64
+		for j, v := range xv {
+			c, z[i+j] = mulAddWWWW(v, d, z[i+j], c)
+		}
+		z[i+len(xv)] = c
+	}
+	hi = u{:bits}FromLimbs(*(*[%[1]d]uint64)(z[%[1]d:]))
+	lo = u{:bits}FromLimbs(*(*[%[1]d]uint64)(z[:%[1]d]))
+	return hi, lo
+}
 
-	// x^0 = 1
-	if y.IsZero() {
-		return U{:bits}From64(1)
+// mod returns x mod m.
+func (x {:name}) mod(m {:name}) {:name} {
+	if x.Cmp(m) < 0 {
+		return x
 	}
+	_, r := x.QuoRem(m)
+	return r
+}
 
-	// x^1 mod m == x mod m
-	mod := !m.IsZero()
-	if y == U{:bits}From64(1) && mod {
-		_, r := x.QuoRem(m)
-		return r
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x {:name}) AddMod(y, m {:name}) {:name} {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
 	}
+	return z
+}
 
-	yv := []uint64{
-`)
-	for i := 0; i < nelems; i++ {
-		p("y.u%d,", i)
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x {:name}) MulMod(y, m {:name}) {:name} {
+	hi, lo := x.mod(m).MulFull(y.mod(m))
+	_, r := div{:bits}(hi, lo, m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x {:name}) SqrMod(m {:name}) {:name} {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x {:name}) MulDiv(y, z {:name}) ({:name}, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x {:name}) MulDivRoundUp(y, z {:name}) ({:name}, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
+
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x {:name}) MulDivRound(y, z {:name}, mode RoundingMode) ({:name}, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return {:name}{}, false
 	}
-	p(`
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in {:name}.
+func (x {:name}) mulDiv(y, z {:name}) (q, r {:name}, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
 	}
-	i := len(yv)
-	for i > 0 && yv[i-1] == 0 {
-		i--
+	hi, lo := x.MulFull(y)
+	if z.Cmp(hi) <= 0 {
+		return {:name}{}, {:name}{}, false
 	}
-	yv = yv[:i]
+	q, r = div{:bits}(hi, lo, z)
+	return q, r, true
+}
 
-	// TODO(eric): if x > 1 and y > 0 && mod, then use montgomery
-	// or windowed exponentiation.
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x {:name}) Exp(y, m {:name}) {:name} {
+	switch {
+	case m.IsZero():
+		return x.exp(y)
+	case m == U{:bits}From64(1):
+		return {:name}{}
+	case m.u0&1 != 0:
+		// NewMont{:bits} only fails for even moduli.
+		c, _ := NewMont{:bits}(m)
+		return c.Exp(x, y)
+	}
 
-	z := x
-	v := yv[len(yv)-1]
-	s := bits.LeadingZeros64(v) + 1
-	v <<= s
-	w := 64 - s
-	for j := 0; j < w; j++ {
-		z = z.Mul(z)
-		if v&mask != 0 {
-			z = z.Mul(x)
+	z := U{:bits}From64(1)
+	x = x.mod(m)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.SqrMod(m)
+		if yv[i/64]>>(i%%64)&1 != 0 {
+			z = z.MulMod(x, m)
 		}
-		if mod {
-			_, z = z.QuoRem(m)
-		}
-		v <<= 1
 	}
+	return z
+}
 
-	for i := len(yv) - 2; i >= 0; i-- {
-		v := yv[i]
-		for j := 0; j < 64; j++ {
-			z = z.Mul(z)
-			if v&mask != 0 {
-				z = z.Mul(x)
-			}
-			if mod {
-				_, z = z.QuoRem(m)
-			}
-			v <<= 1
+// exp returns x^y.
+func (x {:name}) exp(y {:name}) {:name} {
+	z := U{:bits}From64(1)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.Mul(z)
+		if yv[i/64]>>(i%%64)&1 != 0 {
+			z = z.Mul(x)
 		}
 	}
 	return z
-}
+}`, bits/64)
+	p(`
 
 // mulPow10 returns x * 10^n.
 func (x {:name}) mulPow10(n uint) ({:name}, bool) {
@@ -692,17 +1044,17 @@ func (x {:name}) mulPow10(n uint) ({:name}, bool) {
 	case x.IsZero():
 		return {:name}{}, true
 	case n == 0:
-		return x, true`)
+		return x, true
+	case n >= %d:
+		return {:name}{}, false`, tabLen)
 	if bits <= 256 {
 		p(`
-	case n >= %d:
-		return {:name}{}, false
 	default:
-		return x.MulCheck(pow10{:name}(n))`, tabLen)
+		return x.MulCheck(pow10{:name}(n))`)
 	} else {
 		p(`
 	default:
-		return x.MulCheck(U{:bits}From64(10).Exp(U{:bits}From64(uint64(n)), U{:bits}From64(0)))`)
+		return x.MulCheck(U{:bits}From64(10).exp(U{:bits}From64(uint64(n))))`)
 	}
 	p(`
 	}
@@ -715,11 +1067,10 @@ var pow10tab{:name} struct{
 
 func pow10{:name}(n uint) {:name} {
 	pow10tab{:name}.once.Do(func() {
-		tab := make([]{:name}, 2 + %d)
-		tab[0] = {:name}{}
-		tab[1] = U{:bits}From64(1)
-		for i := 2; i < len(tab); i++ {
-			tab[i] = tab[i-1].mul64(10)
+		tab := make([]{:name}, %d)
+		tab[0] = U{:bits}From64(1)
+		for i := 1; i < len(tab); i++ {
+			tab[i] = tab[i-1].Mul64(10)
 		}
 		pow10tab{:name}.values = tab
 	})
@@ -730,7 +1081,10 @@ func pow10{:name}(n uint) {:name} {
 // MulCheck returns x*y and reports whether the multiplication
 // oveflowed.
 func (x {:name}) MulCheck(y {:name}) ({:name}, bool) {
-	if x.BitLen()+y.BitLen() > {:bits} {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > {:bits}+1 {
 		return {:name}{}, false
 	}
 
@@ -766,7 +1120,9 @@ func (x {:name}) MulCheck(y {:name}) ({:name}, bool) {
 	p(`return z, true
 }
 
-func (x {:name}) mulCheck64(y uint64) ({:name}, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x {:name}) MulCheck64(y uint64) ({:name}, bool) {
 	if y == 0 {
 		return {:name}{}, true
 	}
@@ -783,6 +1139,89 @@ func (x {:name}) mulCheck64(y uint64) ({:name}, bool) {
 	return z, true
 }
 
+// SaturatingAdd returns x+y, or the maximum {:name} if x+y
+// overflows.
+func (x {:name}) SaturatingAdd(y {:name}) {:name} {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x {:name}) SaturatingSub(y {:name}) {:name} {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return {:name}{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum {:name} if x*y
+// overflows.
+func (x {:name}) SaturatingMul(y {:name}) {:name} {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum {:name} if any set
+// bits are shifted out.
+func (x {:name}) SaturatingLsh(n uint) {:name} {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x {:name}) Quo(y {:name}) {:name} {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x {:name}) Rem(y {:name}) {:name} {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x {:name}) QuoCeil(y {:name}) {:name} {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x {:name}) QuoRound(y {:name}, mode RoundingMode) {:name} {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [{:name}.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x {:name}) QuoRemCheck(y {:name}) (q, r {:name}, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -805,13 +1244,13 @@ func (x {:name}) QuoRem(y {:name}) (q, r {:name}) {
 	tq, _ := div{:halfBits}(x1.high(), x1.low(), y1.high())
 	tq = tq.Rsh({:halfMask} - n) // tq >>= {:halfMask} - n
 	if !tq.IsZero() {
-		tq = tq.sub64(1) // tq--
+		tq = tq.Sub64(1) // tq--
 	}
 	q = u{:bits}(tq, Uint{:halfBits}{})
 	ytq := y.mul{:halfBits}(tq) // ytq := y*tq
 	r = x.Sub(ytq)      // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
@@ -831,11 +1270,11 @@ func (x {:name}) quoRem{:halfBits}(y Uint{:halfBits}) (q {:name}, r Uint{:halfBi
 	return u{:bits}(lo, hi), r
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x {:name}) quoRem64(y uint64) (q {:name}, r uint64) {
+func (x {:name}) QuoRem64(y uint64) (q {:name}, r uint64) {
 `)
 	p("q.u%[1]d, r = bits.Div64(0, x.u%[1]d, y)\n", nelems)
 	for i := (bits / 64) - 2; i >= 0; i-- {
@@ -871,7 +1310,7 @@ func div{:bits}(hi, lo, y Uint{:bits}) (q, r Uint{:bits}) {
 
 	// for q1 >= two{:halfBits} || q1*yn0 > two{:halfBits}*rhat+un1 { ... }
 	for !q1.high().IsZero() || q1.mul{:halfBits}(yn0).Cmp(u{:bits}(un1, rhat)) > 0 {
-		q1 = q1.sub64(1) // q1--
+		q1 = q1.Sub64(1) // q1--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -884,7 +1323,7 @@ func div{:bits}(hi, lo, y Uint{:bits}) (q, r Uint{:bits}) {
 
 	// for q0 >= two{:halfBits} || q0*yn0 > two{:halfBits}*rhat+un0 { ... }
 	for !q0.high().IsZero() || q0.mul{:halfBits}(yn0).Cmp(u{:bits}(un0, rhat)) > 0 {
-		q0 = q0.sub64(1) // q0--
+		q0 = q0.Sub64(1) // q0--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -898,35 +1337,129 @@ func div{:bits}(hi, lo, y Uint{:bits}) (q, r Uint{:bits}) {
 	return
 }
 
+// DivFull{:bits} returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull{:bits} panics for y == 0 (division by zero) or
+// y <= hi (quotient overflow).
+//
+// It is the {:bits}-bit analogue of [bits.Div64].
+func DivFull{:bits}(hi, lo, y Uint{:bits}) (q, r Uint{:bits}) {
+	return div{:bits}(hi, lo, y)
+}
+
+// GoString returns x as a call to [U{:bits}] that produces x.
 func (x {:name}) GoString() string {
-	return fmt.Sprintf("[`)
+	return fmt.Sprintf("fixed.U{:bits}(`)
 	for i := 0; i < bits/64; i++ {
 		if i > 0 {
-			p(" ")
+			p(", ")
 		}
-		p("%%d")
+		p("%%#x")
 	}
-	p("]\",\n")
+	p(")\",\n")
 	for i := 0; i < bits/64; i++ {
 		p("x.u%d,\n", i)
 	}
 	p(`)
 }
 
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%%#x" and "%%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%%#v" format prints x as a call to [U{:bits}].
+func (x {:name}) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *{:name}) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x {:name}) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x {:name}) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
+}
+
 // String returns the base-10 representation of x.
 func (x {:name}) String() string {
-`)
-	p("b := make([]byte, %d)\n", maxStrLen(uint(bits)))
-	p(`i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	var b [{:strLen}]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x {:name}) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x {:name}) AppendDecimal(dst []byte) []byte {
+	var b [{:strLen}]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every {:name}.
+func (x {:name}) FormatInto(b *[{:strLen}]byte) []byte {
+	i := len(b)
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // Parse{:name} returns the value of s in the given base.
@@ -934,7 +1467,122 @@ func Parse{:name}(s string, base int) ({:name}, error) {
 	x, _, _, err := parseUint[{:name}](s, base, false)
 	return x, err
 }
-`)
+
+// Mont{:bits} is a Montgomery arithmetic context for an odd
+// {:bits}-bit modulus.
+//
+// Values in Montgomery form are represented as x*R mod m, where
+// R = 2^{:bits}.
+//
+// Mont{:bits} is not constant time and should not be used with
+// secret exponents.
+type Mont{:bits} struct {
+	m   [%[1]d]uint64
+	r2  {:name} // R^2 mod m
+	one {:name} // R mod m
+	k   uint64  // -m^-1 mod 2^64
+}
+
+var _ Mont[{:name}] = (*Mont{:bits})(nil)
+
+// NewMont{:bits} creates a Montgomery context for the odd modulus
+// m.
+func NewMont{:bits}(m {:name}) (*Mont{:bits}, error) {
+	if m.u0&1 == 0 {
+		return nil, errEvenModulus
+	}
+	_, one := {:name}{}.Sub(m).QuoRem(m) // 2^{:bits} mod m
+	r2 := one
+	for i := 0; i < {:bits}; i++ {
+		// r2 = 2*r2 mod m
+		var c uint64
+		r2, c = r2.AddCheck(r2)
+		if c != 0 || r2.Cmp(m) >= 0 {
+			r2 = r2.Sub(m)
+		}
+	}
+	return &Mont{:bits}{
+		m:   m.limbs(),
+		r2:  r2,
+		one: one,
+		k:   montK(m.u0),
+	}, nil
+}
+
+// Modulus returns the modulus m.
+func (c *Mont{:bits}) Modulus() {:name} {
+	return u{:bits}FromLimbs(c.m)
+}
+
+// ToMont converts x to Montgomery form.
+func (c *Mont{:bits}) ToMont(x {:name}) {:name} {
+	if m := c.Modulus(); x.Cmp(m) >= 0 {
+		_, x = x.QuoRem(m)
+	}
+	return c.MulMont(x, c.r2)
+}
+
+// FromMont converts x from Montgomery form.
+func (c *Mont{:bits}) FromMont(x {:name}) {:name} {
+	return c.MulMont(x, U{:bits}From64(1))
+}
+
+// MulMont returns x*y*R^-1 mod m.
+//
+// Both x and y must be less than m. If x and y are in
+// Montgomery form, then so is the result.
+func (c *Mont{:bits}) MulMont(x, y {:name}) {:name} {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [%[1]d]uint64
+	var t [%[1]d + 2]uint64
+	montMul(z[:], xv[:], yv[:], c.m[:], t[:], c.k)
+	return u{:bits}FromLimbs(z)
+}
+
+// ExpMont returns x^y mod m, where x and the result are in
+// Montgomery form.
+//
+// x must be less than m.
+func (c *Mont{:bits}) ExpMont(x, y {:name}) {:name} {
+	// Fixed 4-bit window.
+	var tab [16]{:name}
+	tab[0] = c.one
+	tab[1] = x
+	for i := 2; i < len(tab); i++ {
+		tab[i] = c.MulMont(tab[i-1], x)
+	}
+
+	z := c.one
+	started := false
+	yv := y.limbs()
+	for i := len(yv) - 1; i >= 0; i-- {
+		for s := 60; s >= 0; s -= 4 {
+			w := (yv[i] >> s) & 15
+			if !started {
+				if w != 0 {
+					z = tab[w]
+					started = true
+				}
+				continue
+			}
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			if w != 0 {
+				z = c.MulMont(z, tab[w])
+			}
+		}
+	}
+	return z
+}
+
+// Exp returns x^y mod m.
+func (c *Mont{:bits}) Exp(x, y {:name}) {:name} {
+	return c.FromMont(c.ExpMont(c.ToMont(x), y))
+}
+`, bits/64)
 }
 
 func maxStrLen(bits uint) int {
@@ -949,6 +1597,7 @@ func genTest(b *bytes.Buffer, bits int) {
 			named("name", fmt.Sprintf("Uint%d", bits)),
 			named("bits", bits),
 			named("halfBits", bits/2),
+			named("expIters", 256*256/bits),
 		)
 		fprintf(b, format, args...)
 	}
@@ -1056,6 +1705,23 @@ func Test{:name}Cmp(t *testing.T) {
 	}
 }
 
+func Test{:name}Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := rand{:name}()
+		y := randUint64()
+		if i%%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%%d, %%d): expected %%d, got %%d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func Test{:name}And(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := rand{:name}()
@@ -1171,7 +1837,7 @@ func Test{:name}Add64(t *testing.T) {
 		x := rand{:name}()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -1181,9 +1847,9 @@ func Test{:name}Add64(t *testing.T) {
 		}
 		want.And(want, big{:bits}mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%%d: %%d * %%d: %%d != %%d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%%d + %%d: expected %%d, got %%d",
@@ -1222,7 +1888,7 @@ func Test{:name}Sub64(t *testing.T) {
 		x := rand{:name}()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -1232,9 +1898,9 @@ func Test{:name}Sub64(t *testing.T) {
 		}
 		want.And(want, big{:bits}mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%%d: %%d * %%d: %%d != %%d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%%d - %%d: expected %%d, got %%d",
@@ -1274,7 +1940,7 @@ func Test{:name}Mul64(t *testing.T) {
 		x := rand{:name}()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -1284,11 +1950,11 @@ func Test{:name}Mul64(t *testing.T) {
 		}
 		want.And(want, big{:bits}mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%%d: %%d * %%d: %%d != %%d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%%d: %%d * %%d: expected %%d, got %%d",
 				i, x.big(), ybig, want, got)
@@ -1300,7 +1966,7 @@ func Test{:name}Exp(t* testing.T) {
 	x := U{:bits}From64(1)
 	ten := U{:bits}From64(10)
 	for i := 1;; i++ {
-		want, ok := x.mulCheck64(10)
+		want, ok := x.MulCheck64(10)
 		if !ok { break }
 		got := ten.Exp(U{:bits}From64(uint64(i)), U{:bits}From64(0))
 		if got != want {
@@ -1310,6 +1976,126 @@ func Test{:name}Exp(t* testing.T) {
 	}
 }
 
+func Test{:name}ExpMod(t *testing.T) {
+	for i := 0; i < {:expIters}; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+		m := rand{:name}()
+		if m.IsZero() {
+			m = U{:bits}From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d^%%d mod %%d: expected %%d, got %%d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+		m := rand{:name}()
+		if m.IsZero() {
+			m = U{:bits}From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d * %%d mod %%d: expected %%d, got %%d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d^2 mod %%d: expected %%d, got %%d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+		m := rand{:name}()
+		if m.IsZero() {
+			m = U{:bits}From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d + %%d mod %%d: expected %%d, got %%d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func Test{:name}MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), {:bits}), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := rand{:name}()
+		y := rand{:name}()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, {:bits})
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%%d * %%d: expected (%%d, %%d), got (%%d, %%d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull{:bits}(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := rand{:name}()
+		lo := rand{:name}()
+		y := rand{:name}()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull{:bits}(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), {:bits})
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%%d, %%d) / %%d: expected (%%d, %%d), got (%%d, %%d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func Test{:name}MulDiv(t *testing.T) {
+	testMulDiv(t, rand{:name})
+}
+
+func Test{:name}Quo(t *testing.T) {
+	testQuo(t, rand{:name})
+}
+
+func Test{:name}Saturating(t *testing.T) {
+	testSaturating(t, rand{:name})
+}
+
+func Test{:name}MulPow10(t *testing.T) {
+	testMulPow10(t, rand{:name})
+}
+
 func Test{:name}QuoRem(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := rand{:name}()
@@ -1370,7 +2156,7 @@ func Test{:name}QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -1422,6 +2208,70 @@ func TestParse{:name}(t *testing.T) {
 	}
 }
 
+func randMont{:bits}() *Mont{:bits} {
+	m := rand{:name}()
+	m.u0 |= 1
+	c, err := NewMont{:bits}(m)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func TestMont{:bits}Mul(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		c := randMont{:bits}()
+		m := c.Modulus()
+		x := rand{:name}()
+		y := rand{:name}()
+
+		z := c.FromMont(c.MulMont(c.ToMont(x), c.ToMont(y)))
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d * %%d mod %%d: expected %%d, got %%d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestMont{:bits}Exp(t *testing.T) {
+	for i := 0; i < {:expIters}; i++ {
+		c := randMont{:bits}()
+		m := c.Modulus()
+		x := rand{:name}()
+		y := rand{:name}()
+
+		z := c.Exp(x, y)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%%d^%%d mod %%d: expected %%d, got %%d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestNewMont{:bits}Even(t *testing.T) {
+	for _, m := range []{:name}{ {}, U{:bits}From64(2), {:name}{}.max().Sub(U{:bits}From64(1))} {
+		if _, err := NewMont{:bits}(m); err == nil {
+			t.Fatalf("%%d: expected an error", m.big())
+		}
+	}
+}
+
+func BenchmarkMont{:bits}Exp(b *testing.B) {
+	c := randMont{:bits}()
+	x := c.ToMont(rand{:name}())
+	y := {:name}{}.max()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink.{:name} = c.ExpMont(x, y)
+	}
+}
+
 func Benchmark{:name}Add(b *testing.B) {
 	s := make([]{:name}, 1000)
 	for i := range s {
@@ -1472,7 +2322,7 @@ func Benchmark{:name}QuoRem(b *testing.B) {
 
 func Benchmark{:name}QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.{:name}, sink.uint64 = U{:bits}From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.{:name}, sink.uint64 = U{:bits}From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
 `)
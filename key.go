@@ -0,0 +1,152 @@
+package fixed
+
+import "errors"
+
+var (
+	errShortKey     = errors.New("fixed: sortable key too short")
+	errNonCanonical = errors.New("fixed: non-canonical sortable varint")
+)
+
+// SortableKeyLen returns the length in bytes of a sortable key.
+//
+// See [AppendSortableKey].
+func SortableKeyLen[T Uint[T]]() int {
+	return (*new(T)).Size() / 8
+}
+
+// AppendSortableKey appends the sortable key encoding of x to
+// dst and returns the resulting slice.
+//
+// A sortable key is the fixed-length, big-endian encoding of x,
+// so comparing two keys with [bytes.Compare] gives the same
+// result as comparing the integers with Cmp. This makes it
+// suitable for keys in ordered key-value stores.
+func AppendSortableKey[T Uint[T]](dst []byte, x T) []byte {
+	return appendBigEndian(dst, x, SortableKeyLen[T]())
+}
+
+// appendBigEndian appends the low n bytes of x to dst in
+// big-endian order.
+func appendBigEndian[T Uint[T]](dst []byte, x T, n int) []byte {
+	dst = append(dst, make([]byte, n)...)
+	b := dst[len(dst)-n:]
+	for i := n; i > 0; {
+		w := x.uint64()
+		x = x.Rsh(64)
+		for j := 0; j < 8 && i > 0; j++ {
+			i--
+			b[i] = byte(w)
+			w >>= 8
+		}
+	}
+	return dst
+}
+
+// AppendSortableKeyDesc is like [AppendSortableKey], but the
+// resulting keys sort in descending order.
+func AppendSortableKeyDesc[T Uint[T]](dst []byte, x T) []byte {
+	dst = AppendSortableKey(dst, x)
+	invert(dst[len(dst)-SortableKeyLen[T]():])
+	return dst
+}
+
+// ParseSortableKey decodes a key created by [AppendSortableKey]
+// from the start of b.
+//
+// It returns the integer and the remainder of b after the key.
+func ParseSortableKey[T Uint[T]](b []byte) (x T, rest []byte, err error) {
+	n := SortableKeyLen[T]()
+	if len(b) < n {
+		return x, b, errShortKey
+	}
+	return parseBigEndian[T](b[:n]), b[n:], nil
+}
+
+// ParseSortableKeyDesc decodes a key created by
+// [AppendSortableKeyDesc] from the start of b.
+//
+// It returns the integer and the remainder of b after the key.
+func ParseSortableKeyDesc[T Uint[T]](b []byte) (x T, rest []byte, err error) {
+	x, rest, err = ParseSortableKey[T](b)
+	if err != nil {
+		return x, rest, err
+	}
+	return x.Xor(x.max()), rest, nil
+}
+
+// parseBigEndian returns the big-endian integer b, which must
+// fit in a T.
+func parseBigEndian[T Uint[T]](b []byte) T {
+	var x T
+	for i, c := range b {
+		x = x.orLsh64(uint64(c), uint(8*(len(b)-1-i)))
+	}
+	return x
+}
+
+// invert complements each byte in b.
+func invert(b []byte) {
+	for i := range b {
+		b[i] = ^b[i]
+	}
+}
+
+// SortableUvarintLen returns the number of bytes required to
+// encode x with [AppendSortableUvarint].
+func SortableUvarintLen[T Uint[T]](x T) int {
+	n := (x.BitLen() + 7) / 8
+	if n >= 0xff {
+		return n + 2
+	}
+	return n + 1
+}
+
+// AppendSortableUvarint appends the sortable varint encoding of
+// x to dst and returns the resulting slice.
+//
+// Like [AppendSortableKey], comparing two encodings with
+// [bytes.Compare] gives the same result as comparing the
+// integers with Cmp, but small values use fewer bytes.
+//
+// The encoding is the minimal big-endian representation of x
+// prefixed by its length in bytes, so zero is encoded as the
+// single byte 0x00. Lengths of 255 bytes or more, which only
+// occur for [Uint2048], are written as 0xff followed by the
+// length minus 255.
+func AppendSortableUvarint[T Uint[T]](dst []byte, x T) []byte {
+	n := (x.BitLen() + 7) / 8
+	if n >= 0xff {
+		dst = append(dst, 0xff, byte(n-0xff))
+	} else {
+		dst = append(dst, byte(n))
+	}
+	return appendBigEndian(dst, x, n)
+}
+
+// ParseSortableUvarint decodes a varint created by
+// [AppendSortableUvarint] from the start of b.
+//
+// It returns the integer and the remainder of b after the
+// varint. It returns an error if the varint is truncated, does
+// not fit in a T, or is not minimally encoded.
+func ParseSortableUvarint[T Uint[T]](b []byte) (x T, rest []byte, err error) {
+	if len(b) == 0 {
+		return x, b, errShortKey
+	}
+	n, i := int(b[0]), 1
+	if n == 0xff {
+		if len(b) < 2 {
+			return x, b, errShortKey
+		}
+		n, i = 0xff+int(b[1]), 2
+	}
+	switch {
+	case n > SortableKeyLen[T]():
+		return x, b, ErrOverflow
+	case len(b)-i < n:
+		return x, b, errShortKey
+	case n > 0 && b[i] == 0:
+		return x, b, errNonCanonical
+	}
+	return parseBigEndian[T](b[i : i+n]), b[i+n:], nil
+}
@@ -0,0 +1,163 @@
+package fixed
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSortableKey(t *testing.T) {
+	testSortableKey[Uint96](t)
+	testSortableKey[Uint128](t)
+	testSortableKey[Uint192](t)
+	testSortableKey[Uint256](t)
+	testSortableKey[Uint512](t)
+	testSortableKey[Uint1024](t)
+	testSortableKey[Uint2048](t)
+}
+
+func testSortableKey[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size()
+		n := SortableKeyLen[T]()
+		gen := func() T {
+			switch rand.Intn(4) {
+			case 0:
+				return zero.Add64(rand.Uint64() % 4)
+			case 1:
+				return zero.max().Sub64(rand.Uint64() % 4)
+			default:
+				return randUint[T]().Rsh(uint(rand.Intn(size)))
+			}
+		}
+		for i := 0; i < 10_000; i++ {
+			x := gen()
+			// Make y share a prefix with x half the time.
+			y := gen()
+			if rand.Intn(2) == 0 {
+				s := uint(rand.Intn(size))
+				y = x.Rsh(s).Lsh(s).Xor(y.Lsh(uint(size) - s).Rsh(uint(size) - s))
+			}
+
+			kx := AppendSortableKey([]byte("p"), x)
+			ky := AppendSortableKey(nil, y)
+			if len(kx) != n+1 || len(ky) != n {
+				t.Fatalf("expected %d bytes, got %d", n, len(ky))
+			}
+			if got, want := bytes.Compare(kx[1:], ky), x.Cmp(y); got != want {
+				t.Fatalf("Compare(%s, %s): expected %d, got %d", x, y, want, got)
+			}
+			dx := AppendSortableKeyDesc(nil, x)
+			dy := AppendSortableKeyDesc([]byte("p"), y)
+			if got, want := bytes.Compare(dx, dy[1:]), y.Cmp(x); got != want {
+				t.Fatalf("Compare(desc(%s), desc(%s)): expected %d, got %d", x, y, want, got)
+			}
+
+			got, rest, err := ParseSortableKey[T](append(kx[1:], "suffix"...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(x) || string(rest) != "suffix" {
+				t.Fatalf("ParseSortableKey: expected (%s, %q), got (%s, %q)", x, "suffix", got, rest)
+			}
+			got, rest, err = ParseSortableKeyDesc[T](dy[1:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(y) || len(rest) != 0 {
+				t.Fatalf("ParseSortableKeyDesc: expected %s, got (%s, %q)", y, got, rest)
+			}
+		}
+
+		short := make([]byte, n-1)
+		if _, _, err := ParseSortableKey[T](short); !errors.Is(err, errShortKey) {
+			t.Fatalf("expected %v, got %v", errShortKey, err)
+		}
+		if _, _, err := ParseSortableKeyDesc[T](short); !errors.Is(err, errShortKey) {
+			t.Fatalf("expected %v, got %v", errShortKey, err)
+		}
+	})
+}
+
+func TestSortableUvarint(t *testing.T) {
+	testSortableUvarint[Uint96](t)
+	testSortableUvarint[Uint128](t)
+	testSortableUvarint[Uint192](t)
+	testSortableUvarint[Uint256](t)
+	testSortableUvarint[Uint512](t)
+	testSortableUvarint[Uint1024](t)
+	testSortableUvarint[Uint2048](t)
+}
+
+func testSortableUvarint[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		size := zero.Size()
+		gen := func() T {
+			switch rand.Intn(4) {
+			case 0:
+				return zero.Add64(rand.Uint64() % 300)
+			case 1:
+				return zero.max().Sub64(rand.Uint64() % 4)
+			default:
+				return randUint[T]().Rsh(uint(rand.Intn(size)))
+			}
+		}
+		for i := 0; i < 10_000; i++ {
+			x, y := gen(), gen()
+			kx := AppendSortableUvarint([]byte("p"), x)
+			ky := AppendSortableUvarint(nil, y)
+			if n := SortableUvarintLen(x); len(kx) != n+1 {
+				t.Fatalf("SortableUvarintLen(%s): expected %d, got %d", x, len(kx)-1, n)
+			}
+			if got, want := bytes.Compare(kx[1:], ky), x.Cmp(y); got != want {
+				t.Fatalf("Compare(%s, %s): expected %d, got %d", x, y, want, got)
+			}
+
+			got, rest, err := ParseSortableUvarint[T](append(kx[1:], "suffix"...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(x) || string(rest) != "suffix" {
+				t.Fatalf("ParseSortableUvarint: expected (%s, %q), got (%s, %q)", x, "suffix", got, rest)
+			}
+		}
+
+		if got := AppendSortableUvarint(nil, zero); !bytes.Equal(got, []byte{0}) {
+			t.Fatalf("expected 00, got %x", got)
+		}
+		if got := AppendSortableUvarint(nil, zero.Add64(0x1234)); !bytes.Equal(got, []byte{2, 0x12, 0x34}) {
+			t.Fatalf("expected 021234, got %x", got)
+		}
+
+		max := AppendSortableUvarint(nil, zero.max())
+		// long has a length prefix one byte too large for T.
+		long := []byte{byte(size/8 + 1)}
+		if size/8+1 >= 0xff {
+			long = []byte{0xff, byte(size/8 + 1 - 0xff)}
+		}
+		long = append(long, make([]byte, size/8+1)...)
+		long[len(long)-size/8-1] = 1
+		for _, tc := range []struct {
+			b   []byte
+			err error
+		}{
+			{nil, errShortKey},
+			{[]byte{1}, errShortKey},
+			{[]byte{0xff}, errShortKey},
+			{max[:len(max)-1], errShortKey},
+			{[]byte{1, 0}, errNonCanonical},
+			{[]byte{2, 0, 1}, errNonCanonical},
+			{long, ErrOverflow},
+			{[]byte{0xff, 0xff}, ErrOverflow},
+		} {
+			if _, _, err := ParseSortableUvarint[T](tc.b); !errors.Is(err, tc.err) {
+				t.Fatalf("ParseSortableUvarint(%x): expected %v, got %v", tc.b, tc.err, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package fixed
+
+import (
+	"errors"
+	"math/bits"
+)
+
+var errEvenModulus = errors.New("fixed: Montgomery modulus must be odd")
+
+// Mont is a Montgomery arithmetic context for an odd modulus m.
+//
+// Values in Montgomery form are represented as x*R mod m, where
+// R = 2^n and n is the width of T in bits.
+type Mont[T any] interface {
+	// Modulus returns the modulus m.
+	Modulus() T
+	// ToMont converts x to Montgomery form.
+	ToMont(x T) T
+	// FromMont converts x from Montgomery form.
+	FromMont(x T) T
+	// MulMont returns x*y*R^-1 mod m.
+	//
+	// If x and y are in Montgomery form, then so is the
+	// result.
+	MulMont(x, y T) T
+	// ExpMont returns x^y mod m, where x and the result are in
+	// Montgomery form.
+	ExpMont(x, y T) T
+	// Exp returns x^y mod m.
+	Exp(x, y T) T
+}
+
+// montK returns -m^-1 mod 2^64 for an odd m.
+func montK(m uint64) uint64 {
+	// For an odd m, m*m = 1 mod 8, so m is its own inverse
+	// modulo 2^3. Each Newton iteration doubles the number of
+	// correct bits: 3, 6, 12, 24, 48, 96.
+	inv := m
+	for i := 0; i < 5; i++ {
+		inv *= 2 - m*inv
+	}
+	return -inv
+}
+
+// montMul sets z = x*y*R^-1 mod m, where R = 2^(64*len(m)).
+//
+// x and y must be less than m and k must be -m^-1 mod 2^64. t is
+// scratch space with a length of at least len(m)+2.
+//
+// montMul uses the Coarsely Integrated Operand Scanning (CIOS)
+// method from "Analyzing and Comparing Montgomery Multiplication
+// Algorithms" by Koç, Acar, and Kaliski.
+func montMul(z, x, y, m, t []uint64, k uint64) {
+	n := len(m)
+	t = t[:n+2]
+	for i := range t {
+		t[i] = 0
+	}
+	for i := 0; i < n; i++ {
+		// t += x*y[i]
+		var c uint64
+		for j := 0; j < n; j++ {
+			c, t[j] = mulAddWWWW(x[j], y[i], t[j], c)
+		}
+		t[n], c = bits.Add64(t[n], c, 0)
+		t[n+1] = c
+
+		// t = (t + u*m) / 2^64, where u is chosen so that the
+		// low word of t + u*m is zero.
+		u := t[0] * k
+		c, _ = mulAddWWW(u, m[0], t[0])
+		for j := 1; j < n; j++ {
+			c, t[j-1] = mulAddWWWW(u, m[j], t[j], c)
+		}
+		t[n-1], c = bits.Add64(t[n], c, 0)
+		t[n] = t[n+1] + c
+	}
+
+	// t < 2m, so at most one subtraction is needed.
+	var b uint64
+	for i := 0; i < n; i++ {
+		z[i], b = bits.Sub64(t[i], m[i], b)
+	}
+	if t[n] < b {
+		// t < m
+		copy(z, t[:n])
+	}
+}
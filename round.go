@@ -0,0 +1,165 @@
+package fixed
+
+import "strconv"
+
+// RoundingMode determines how a result is rounded when it
+// cannot be represented exactly.
+type RoundingMode uint8
+
+const (
+	// ToNearestEven rounds to the nearest value, with ties
+	// rounded to the nearest even value.
+	ToNearestEven RoundingMode = iota
+	// ToNearestAway rounds to the nearest value, with ties
+	// rounded away from zero.
+	ToNearestAway
+	// ToNearestZero rounds to the nearest value, with ties
+	// rounded toward zero.
+	ToNearestZero
+	// ToZero truncates toward zero.
+	ToZero
+	// AwayFromZero rounds away from zero.
+	AwayFromZero
+	// ToNegativeInf rounds toward negative infinity.
+	ToNegativeInf
+	// ToPositiveInf rounds toward positive infinity.
+	ToPositiveInf
+)
+
+// String returns the name of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case ToNearestEven:
+		return "ToNearestEven"
+	case ToNearestAway:
+		return "ToNearestAway"
+	case ToNearestZero:
+		return "ToNearestZero"
+	case ToZero:
+		return "ToZero"
+	case AwayFromZero:
+		return "AwayFromZero"
+	case ToNegativeInf:
+		return "ToNegativeInf"
+	case ToPositiveInf:
+		return "ToPositiveInf"
+	default:
+		return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+	}
+}
+
+// inc reports whether a truncated quotient should be
+// incremented (in magnitude) to round it according to m.
+//
+// half is the result of comparing the remainder against half of
+// the divisor, exact reports whether the remainder is zero, odd
+// reports whether the truncated quotient is odd, and neg reports
+// whether the true result is negative.
+func (m RoundingMode) inc(half int, exact, odd, neg bool) bool {
+	if exact {
+		return false
+	}
+	switch m {
+	case ToNearestEven:
+		return half > 0 || (half == 0 && odd)
+	case ToNearestAway:
+		return half >= 0
+	case ToNearestZero:
+		return half > 0
+	case AwayFromZero:
+		return true
+	case ToNegativeInf:
+		return neg
+	case ToPositiveInf:
+		return !neg
+	default:
+		return false
+	}
+}
+
+// roundQuo rounds the truncated quotient q = x/y with remainder
+// r according to mode.
+//
+// sticky reports whether x itself was inexact (i.e., had bits
+// below its least significant digit that were discarded).
+//
+// It reports false if rounding overflows q.
+func roundQuo[T Uint[T]](q, r, y T, sticky, neg bool, mode RoundingMode) (T, bool) {
+	half := r.Cmp(y.Sub(r)) // r vs y/2
+	if half == 0 && sticky {
+		half = +1
+	}
+	exact := r.IsZero() && !sticky
+	if !mode.inc(half, exact, q.uint8()&1 != 0, neg) {
+		return q, true
+	}
+	q, c := q.AddCheck64(1)
+	return q, c == 0
+}
+
+// quoRound returns x/y rounded according to mode.
+func quoRound[T Uint[T]](x, y T, mode RoundingMode) T {
+	q, r := x.QuoRem(y)
+	// If r != 0, then y > 1 and q < max, so rounding cannot
+	// overflow.
+	q, _ = roundQuo(q, r, y, false, false, mode)
+	return q
+}
+
+// quoRemCheck is like x.QuoRem(y), but returns
+// ErrDivisionByZero instead of panicking if y == 0.
+func quoRemCheck[T Uint[T]](x, y T) (q, r T, err error) {
+	if y.IsZero() {
+		return q, r, ErrDivisionByZero
+	}
+	q, r = x.QuoRem(y)
+	return q, r, nil
+}
+
+// pow10 returns 10^n.
+//
+// It reports false if 10^n overflows T.
+func pow10[T Uint[T]](n int) (T, bool) {
+	return (*new(T)).Add64(1).mulPow10(uint(n))
+}
+
+// scaleUp returns x * 10^n.
+//
+// It reports false if the result overflows T.
+func scaleUp[T Uint[T]](x T, n int) (T, bool) {
+	if n <= 0 {
+		return x, true
+	}
+	return x.mulPow10(uint(n))
+}
+
+// scaleDown returns x / 10^n rounded according to mode.
+//
+// See roundQuo for the meaning of sticky and neg.
+func scaleDown[T Uint[T]](x T, n int, sticky, neg bool, mode RoundingMode) T {
+	if n <= 0 {
+		q, _ := roundQuo(x, *new(T), (*new(T)).Add64(1), sticky, neg, mode)
+		return q
+	}
+	if p, ok := pow10[T](n); ok {
+		q, r := x.QuoRem(p)
+		q, _ = roundQuo(q, r, p, sticky, neg, mode)
+		return q
+	}
+
+	// 10^n > x, so the quotient is zero and the remainder is x.
+	// Compare x against 10^n/2 = 5*10^(n-1), which might also
+	// overflow T.
+	half := -1
+	if h, ok := scaleUp((*new(T)).Add64(5), n-1); ok {
+		half = x.Cmp(h)
+	}
+	if half == 0 && sticky {
+		half = +1
+	}
+	exact := x.IsZero() && !sticky
+	if mode.inc(half, exact, false, neg) {
+		return (*new(T)).Add64(1)
+	}
+	return *new(T)
+}
@@ -0,0 +1,117 @@
+package fixed
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// SQLScanner returns a [database/sql.Scanner] that stores
+// scanned values in x.
+//
+// The integer types implement [fmt.Scanner], so they cannot
+// implement [database/sql.Scanner] themselves. Use SQLScanner
+// instead:
+//
+//	var x fixed.Uint256
+//	err := row.Scan(fixed.SQLScanner(&x))
+//
+// The scanner accepts int64, float64, []byte, and string
+// sources. Strings must hold base 10 integers and float64
+// values must be non-negative integers. It returns an error
+// if the source is NULL; use [Null] for nullable columns.
+func SQLScanner[T Uint[T]](x *T) sql.Scanner {
+	return sqlScanner[T]{x}
+}
+
+type sqlScanner[T Uint[T]] struct {
+	x *T
+}
+
+var _ sql.Scanner = sqlScanner[Uint256]{}
+
+// Scan implements [database/sql.Scanner].
+func (s sqlScanner[T]) Scan(src any) error {
+	return scanSQL(s.x, src)
+}
+
+// Null is an integer that may be NULL.
+//
+// It implements [database/sql.Scanner] and
+// [database/sql/driver.Valuer], so it can be used as a scan
+// destination and as a query argument.
+type Null[T Uint[T]] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+var (
+	_ sql.Scanner   = (*Null[Uint256])(nil)
+	_ driver.Valuer = Null[Uint256]{}
+)
+
+// Scan implements [database/sql.Scanner].
+//
+// If src is NULL or cannot be scanned, n is set to NULL.
+func (n *Null[T]) Scan(src any) error {
+	var zero T
+	n.V, n.Valid = zero, false
+	if src == nil {
+		return nil
+	}
+	if err := scanSQL(&n.V, src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements [database/sql/driver.Valuer].
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.V.String(), nil
+}
+
+// scanSQL sets x to the database value src.
+func scanSQL[T Uint[T]](x *T, src any) error {
+	const fnScan = "Scan"
+
+	var zero T
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return errNegative
+		}
+		*x = zero.Add64(uint64(v))
+		return nil
+	case float64:
+		if v != math.Trunc(v) && !math.IsNaN(v) {
+			return fmt.Errorf("fixed: cannot convert non-integer value %g", v)
+		}
+		z, err := fromFloat64[T](v, ToZero)
+		if err != nil {
+			return err
+		}
+		*x = z
+		return nil
+	case []byte:
+		return scanSQL(x, string(v))
+	case string:
+		z, _, _, err := parseUint[T](v, 10, false)
+		if err != nil {
+			err.(*strconv.NumError).Func = fnScan
+			return err
+		}
+		*x = z
+		return nil
+	case nil:
+		return errors.New("fixed: cannot scan NULL")
+	default:
+		return fmt.Errorf("fixed: cannot scan %T into fixed.Uint%d", src, zero.Size())
+	}
+}
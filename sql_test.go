@@ -0,0 +1,175 @@
+package fixed
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// echoDriver is a fake database driver whose queries return
+// a single row containing the query's arguments.
+type echoDriver struct{}
+
+func init() {
+	sql.Register("fixed-echo", echoDriver{})
+}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{row: args}, nil
+}
+
+type echoRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *echoRows) Columns() []string { return make([]string, len(r.row)) }
+func (r *echoRows) Close() error      { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+	return nil
+}
+
+func TestSQL(t *testing.T) {
+	testSQL[Uint96](t)
+	testSQL[Uint128](t)
+	testSQL[Uint192](t)
+	testSQL[Uint256](t)
+	testSQL[Uint512](t)
+	testSQL[Uint1024](t)
+	testSQL[Uint2048](t)
+}
+
+func testSQL[T interface {
+	Uint[T]
+	driver.Valuer
+}](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		db, err := sql.Open("fixed-echo", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		var zero T
+		for i := 0; i < 100; i++ {
+			want := randUint[T]().Rsh(uint(rand.Intn(zero.Size())))
+			v, err := want.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v != want.String() {
+				t.Fatalf("Value: expected %q, got %#v", want.String(), v)
+			}
+
+			var got T
+			if err := db.QueryRow("", want).Scan(SQLScanner(&got)); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(want) {
+				t.Fatalf("expected %s, got %s", want, got)
+			}
+
+			var n Null[T]
+			if err := db.QueryRow("", Null[T]{V: want, Valid: true}).Scan(&n); err != nil {
+				t.Fatal(err)
+			}
+			if !n.Valid || !n.V.Equal(want) {
+				t.Fatalf("Null: expected %s, got %+v", want, n)
+			}
+		}
+
+		max := toBig(t, zero.max())
+		for _, tc := range []struct {
+			src  any
+			want T
+		}{
+			{int64(0), zero},
+			{int64(math.MaxInt64), zero.Add64(math.MaxInt64)},
+			{float64(0), zero},
+			{float64(1 << 63), zero.Add64(1 << 63)},
+			{[]byte("1234"), zero.Add64(1234)},
+			{"1234", zero.Add64(1234)},
+			{max.String(), zero.max()},
+		} {
+			var got T
+			if err := db.QueryRow("", tc.src).Scan(SQLScanner(&got)); err != nil {
+				t.Fatalf("Scan(%#v): %v", tc.src, err)
+			}
+			if !got.Equal(tc.want) {
+				t.Fatalf("Scan(%#v): expected %s, got %s", tc.src, tc.want, got)
+			}
+		}
+
+		overflow := new(big.Int).Add(max, big.NewInt(1))
+		for _, src := range []any{
+			nil,
+			int64(-1),
+			float64(-1),
+			1.5,
+			math.NaN(),
+			math.Inf(1),
+			math.Ldexp(1, zero.Size()),
+			"",
+			"-1",
+			"1.0",
+			"0x10",
+			"abc",
+			overflow.String(),
+			[]byte(overflow.String()),
+			true,
+		} {
+			var got T
+			if err := db.QueryRow("", src).Scan(SQLScanner(&got)); err == nil {
+				t.Fatalf("Scan(%#v): expected an error", src)
+			}
+		}
+
+		for _, src := range []any{"abc", int64(-1), 1.5} {
+			n := Null[T]{V: zero.Add64(1), Valid: true}
+			if err := db.QueryRow("", src).Scan(&n); err == nil {
+				t.Fatalf("Null.Scan(%#v): expected an error", src)
+			}
+			if n.Valid || !n.V.IsZero() {
+				t.Fatalf("Null.Scan(%#v): expected NULL, got %+v", src, n)
+			}
+		}
+
+		n := Null[T]{V: randUint[T](), Valid: true}
+		if err := db.QueryRow("", nil).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		if n.Valid || !n.V.IsZero() {
+			t.Fatalf("Null: expected NULL, got %+v", n)
+		}
+		if v, err := n.Value(); v != nil || err != nil {
+			t.Fatalf("Null.Value: expected (nil, nil), got (%#v, %v)", v, err)
+		}
+	})
+}
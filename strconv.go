@@ -1,5 +1,7 @@
 package fixed
 
+import "strconv"
+
 func parseUint[T Uint[T]](s string, base int, expOK bool) (T, int, int, error) {
 	const fnParseUint = "ParseUintX"
 
@@ -49,7 +51,7 @@ func parseUint[T Uint[T]](s string, base int, expOK bool) (T, int, int, error) {
 		var d byte
 		switch {
 		case c == '.' && expOK:
-			if dotIdx > 0 {
+			if dotIdx >= 0 {
 				return *new(T), 0, 0, syntaxError(fnParseUint, s0)
 			}
 			dotIdx = i
@@ -70,14 +72,14 @@ func parseUint[T Uint[T]](s string, base int, expOK bool) (T, int, int, error) {
 		}
 
 		var ok bool
-		n, ok = n.mulCheck64(uint64(base))
+		n, ok = n.MulCheck64(uint64(base))
 		if !ok {
 			// n*base overflows
 			return (*new(T)).max(), 0, 0, rangeError(fnParseUint, s0)
 		}
 
 		var carry uint64
-		n, carry = n.addCheck64(uint64(d))
+		n, carry = n.AddCheck64(uint64(d))
 		if carry != 0 {
 			// n+d overflows
 			return (*new(T)).max(), 0, 0, rangeError(fnParseUint, s0)
@@ -85,3 +87,43 @@ func parseUint[T Uint[T]](s string, base int, expOK bool) (T, int, int, error) {
 	}
 	return n, len(s), dotIdx, nil
 }
+
+func parseInt[T Uint[T]](s string, base int) (T, error) {
+	const fnParseInt = "ParseIntX"
+
+	if s == "" {
+		return *new(T), syntaxError(fnParseInt, s)
+	}
+
+	// Pick off leading sign.
+	s0 := s
+	neg := false
+	switch s[0] {
+	case '+':
+		s = s[1:]
+	case '-':
+		neg = true
+		s = s[1:]
+	}
+
+	// Convert unsigned and check range.
+	un, _, _, err := parseUint[T](s, base, false)
+	if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+		err.(*strconv.NumError).Func = fnParseInt
+		err.(*strconv.NumError).Num = cloneString(s0)
+		return *new(T), err
+	}
+
+	max := (*new(T)).max().Rsh(1) // 1<<(n-1) - 1
+	cutoff := max.Add64(1)        // 1<<(n-1)
+	if !neg && un.Cmp(cutoff) >= 0 {
+		return max, rangeError(fnParseInt, s0)
+	}
+	if neg && un.Cmp(cutoff) > 0 {
+		return cutoff, rangeError(fnParseInt, s0)
+	}
+	if neg {
+		un = (*new(T)).Sub(un)
+	}
+	return un, nil
+}
@@ -3,9 +3,11 @@
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"sync"
 )
@@ -38,6 +40,15 @@ func U1024From64(x uint64) Uint1024 {
 	return Uint1024{u0: x}
 }
 
+// U1024FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint1024].
+func U1024FromFloat64(f float64, mode RoundingMode) (Uint1024, error) {
+	return fromFloat64[Uint1024](f, mode)
+}
+
 func u1024(lo, hi Uint512) Uint1024 {
 	return Uint1024{
 		lo.u0,
@@ -108,6 +119,21 @@ func (x Uint1024) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+func (x Uint1024) uint64() uint64 {
+	return x.u0
+}
+
+// limbs returns x as little-endian 64-bit words.
+func (x Uint1024) limbs() [16]uint64 {
+	return [16]uint64{x.u0, x.u1, x.u2, x.u3, x.u4, x.u5, x.u6, x.u7, x.u8, x.u9, x.u10, x.u11, x.u12, x.u13, x.u14, x.u15}
+}
+
+// u1024FromLimbs constructs a [Uint1024] from little-endian
+// 64-bit words.
+func u1024FromLimbs(v [16]uint64) Uint1024 {
+	return Uint1024{v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7], v[8], v[9], v[10], v[11], v[12], v[13], v[14], v[15]}
+}
+
 // Bytes encodes x as a little-endian integer.
 func (x Uint1024) Bytes(b *[128]byte) {
 	binary.LittleEndian.PutUint64(b[0:], x.u0)
@@ -153,6 +179,257 @@ func (x *Uint1024) SetBytes(b []byte) error {
 	return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint1024) BigEndianBytes(b *[128]byte) {
+	binary.BigEndian.PutUint64(b[0:], x.u15)
+	binary.BigEndian.PutUint64(b[8:], x.u14)
+	binary.BigEndian.PutUint64(b[16:], x.u13)
+	binary.BigEndian.PutUint64(b[24:], x.u12)
+	binary.BigEndian.PutUint64(b[32:], x.u11)
+	binary.BigEndian.PutUint64(b[40:], x.u10)
+	binary.BigEndian.PutUint64(b[48:], x.u9)
+	binary.BigEndian.PutUint64(b[56:], x.u8)
+	binary.BigEndian.PutUint64(b[64:], x.u7)
+	binary.BigEndian.PutUint64(b[72:], x.u6)
+	binary.BigEndian.PutUint64(b[80:], x.u5)
+	binary.BigEndian.PutUint64(b[88:], x.u4)
+	binary.BigEndian.PutUint64(b[96:], x.u3)
+	binary.BigEndian.PutUint64(b[104:], x.u2)
+	binary.BigEndian.PutUint64(b[112:], x.u1)
+	binary.BigEndian.PutUint64(b[120:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint1024) SetBigEndianBytes(b []byte) error {
+	if len(b) != 128 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u15 = binary.BigEndian.Uint64(b[0:])
+	x.u14 = binary.BigEndian.Uint64(b[8:])
+	x.u13 = binary.BigEndian.Uint64(b[16:])
+	x.u12 = binary.BigEndian.Uint64(b[24:])
+	x.u11 = binary.BigEndian.Uint64(b[32:])
+	x.u10 = binary.BigEndian.Uint64(b[40:])
+	x.u9 = binary.BigEndian.Uint64(b[48:])
+	x.u8 = binary.BigEndian.Uint64(b[56:])
+	x.u7 = binary.BigEndian.Uint64(b[64:])
+	x.u6 = binary.BigEndian.Uint64(b[72:])
+	x.u5 = binary.BigEndian.Uint64(b[80:])
+	x.u4 = binary.BigEndian.Uint64(b[88:])
+	x.u3 = binary.BigEndian.Uint64(b[96:])
+	x.u2 = binary.BigEndian.Uint64(b[104:])
+	x.u1 = binary.BigEndian.Uint64(b[112:])
+	x.u0 = binary.BigEndian.Uint64(b[120:])
+	return nil
+}
+
+// AppendBytes appends the 128-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint1024) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint64(dst, x.u1)
+		dst = order.AppendUint64(dst, x.u2)
+		dst = order.AppendUint64(dst, x.u3)
+		dst = order.AppendUint64(dst, x.u4)
+		dst = order.AppendUint64(dst, x.u5)
+		dst = order.AppendUint64(dst, x.u6)
+		dst = order.AppendUint64(dst, x.u7)
+		dst = order.AppendUint64(dst, x.u8)
+		dst = order.AppendUint64(dst, x.u9)
+		dst = order.AppendUint64(dst, x.u10)
+		dst = order.AppendUint64(dst, x.u11)
+		dst = order.AppendUint64(dst, x.u12)
+		dst = order.AppendUint64(dst, x.u13)
+		dst = order.AppendUint64(dst, x.u14)
+		dst = order.AppendUint64(dst, x.u15)
+		return dst
+	}
+	dst = order.AppendUint64(dst, x.u15)
+	dst = order.AppendUint64(dst, x.u14)
+	dst = order.AppendUint64(dst, x.u13)
+	dst = order.AppendUint64(dst, x.u12)
+	dst = order.AppendUint64(dst, x.u11)
+	dst = order.AppendUint64(dst, x.u10)
+	dst = order.AppendUint64(dst, x.u9)
+	dst = order.AppendUint64(dst, x.u8)
+	dst = order.AppendUint64(dst, x.u7)
+	dst = order.AppendUint64(dst, x.u6)
+	dst = order.AppendUint64(dst, x.u5)
+	dst = order.AppendUint64(dst, x.u4)
+	dst = order.AppendUint64(dst, x.u3)
+	dst = order.AppendUint64(dst, x.u2)
+	dst = order.AppendUint64(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint1024) FillBytes(buf []byte) []byte {
+	var b [128]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 128 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 128 bytes.
+func (x *Uint1024) SetBytesPadded(b []byte) error {
+	if len(b) > 128 {
+		return ErrOverflow
+	}
+	var be [128]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 128 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint1024) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 128)
+	_ = x.SetBytesPadded(b) // len(b) <= 128
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint1024) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint1024) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint1024.Bytes].
+func (x Uint1024) MarshalBinary() ([]byte, error) {
+	var b [128]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint1024.SetBytes].
+func (x *Uint1024) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint1024) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint1024) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint1024) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint1024) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint1024].
+func (x *Uint1024) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint1024) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint1024) FillBig(z *big.Int) *big.Int {
+	v := x.limbs()
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^1024 and reports whether v is
+// negative or does not fit in 1024 bits.
+func (x *Uint1024) SetBig(v *big.Int) (overflow bool) {
+	var z [16]uint64
+	overflow = setBig(z[:], v, 1024)
+	*x = u1024FromLimbs(z)
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint1024) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^1024, and reports whether the rounded value is
+// negative or does not fit in 1024 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint1024) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint1024{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint1024) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint1024) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint1024) Size() int {
 	return 1024
@@ -248,12 +525,12 @@ func (x Uint1024) Cmp(y Uint1024) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint1024) cmp64(y uint64) int {
+func (x Uint1024) Cmp64(y uint64) int {
 	v := x
 	v.u0 = 0
 	if !v.IsZero() {
@@ -805,8 +1082,8 @@ func (x Uint1024) Add(y Uint1024) Uint1024 {
 	return z
 }
 
-// add64 returns x+y.
-func (x Uint1024) add64(y uint64) Uint1024 {
+// Add64 returns x+y.
+func (x Uint1024) Add64(y uint64) Uint1024 {
 	var z Uint1024
 	var carry uint64
 	z.u0, carry = bits.Add64(x.u0, y, 0)
@@ -851,10 +1128,10 @@ func (x Uint1024) AddCheck(y Uint1024) (z Uint1024, carry uint64) {
 	return z, carry
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint1024) addCheck64(y uint64) (z Uint1024, carry uint64) {
+func (x Uint1024) AddCheck64(y uint64) (z Uint1024, carry uint64) {
 	z.u0, carry = bits.Add64(x.u0, y, 0)
 	z.u1, carry = bits.Add64(x.u1, 0, carry)
 	z.u2, carry = bits.Add64(x.u2, 0, carry)
@@ -897,8 +1174,8 @@ func (x Uint1024) Sub(y Uint1024) Uint1024 {
 	return z
 }
 
-// sub64 returns x-y.
-func (x Uint1024) sub64(y uint64) Uint1024 {
+// Sub64 returns x-y.
+func (x Uint1024) Sub64(y uint64) Uint1024 {
 	var z Uint1024
 	var borrow uint64
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
@@ -943,10 +1220,10 @@ func (x Uint1024) SubCheck(y Uint1024) (z Uint1024, borrow uint64) {
 	return z, borrow
 }
 
-// subCheck64 returns x-y.
+// SubCheck64 returns x-y.
 //
 // borrow is 1 if x-y overflows and 0 otherwise.
-func (x Uint1024) subCheck64(y uint64) (z Uint1024, borrow uint64) {
+func (x Uint1024) SubCheck64(y uint64) (z Uint1024, borrow uint64) {
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
 	z.u1, borrow = bits.Sub64(x.u1, 0, borrow)
 	z.u2, borrow = bits.Sub64(x.u2, 0, borrow)
@@ -1313,7 +1590,8 @@ func (x Uint1024) mul512(y Uint512) Uint1024 {
 	return z
 }
 
-func (x Uint1024) mul64(y uint64) Uint1024 {
+// Mul64 returns x*y.
+func (x Uint1024) Mul64(y uint64) Uint1024 {
 	if y == 0 {
 		return Uint1024{}
 	}
@@ -1338,63 +1616,156 @@ func (x Uint1024) mul64(y uint64) Uint1024 {
 	return z
 }
 
-// Exp return x^y mod m.
+// MulFull returns the full 2*1024-bit product x*y as
+// (hi, lo).
 //
-// If m == 0, Exp simply returns x^y.
-func (x Uint1024) Exp(y, m Uint1024) Uint1024 {
-	const mask = 1 << (64 - 1)
-
-	// x^0 = 1
-	if y.IsZero() {
-		return U1024From64(1)
+// It is the 1024-bit analogue of [bits.Mul64].
+func (x Uint1024) MulFull(y Uint1024) (hi, lo Uint1024) {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [2 * 16]uint64
+	for i, d := range yv {
+		if d == 0 {
+			continue
+		}
+		var c uint64
+		for j, v := range xv {
+			c, z[i+j] = mulAddWWWW(v, d, z[i+j], c)
+		}
+		z[i+len(xv)] = c
 	}
+	hi = u1024FromLimbs(*(*[16]uint64)(z[16:]))
+	lo = u1024FromLimbs(*(*[16]uint64)(z[:16]))
+	return hi, lo
+}
 
-	// x^1 mod m == x mod m
-	mod := !m.IsZero()
-	if y == U1024From64(1) && mod {
-		_, r := x.QuoRem(m)
-		return r
+// mod returns x mod m.
+func (x Uint1024) mod(m Uint1024) Uint1024 {
+	if x.Cmp(m) < 0 {
+		return x
 	}
+	_, r := x.QuoRem(m)
+	return r
+}
 
-	yv := []uint64{
-		y.u0, y.u1, y.u2, y.u3, y.u4, y.u5, y.u6, y.u7, y.u8, y.u9, y.u10, y.u11, y.u12, y.u13, y.u14,
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint1024) AddMod(y, m Uint1024) Uint1024 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
 	}
-	i := len(yv)
-	for i > 0 && yv[i-1] == 0 {
-		i--
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint1024) MulMod(y, m Uint1024) Uint1024 {
+	hi, lo := x.mod(m).MulFull(y.mod(m))
+	_, r := div1024(hi, lo, m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint1024) SqrMod(m Uint1024) Uint1024 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint1024) MulDiv(y, z Uint1024) (Uint1024, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint1024) MulDivRoundUp(y, z Uint1024) (Uint1024, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
+
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint1024) MulDivRound(y, z Uint1024, mode RoundingMode) (Uint1024, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint1024{}, false
 	}
-	yv = yv[:i]
+	return roundQuo(q, r, z, false, false, mode)
+}
 
-	// TODO(eric): if x > 1 and y > 0 && mod, then use montgomery
-	// or windowed exponentiation.
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint1024.
+func (x Uint1024) mulDiv(y, z Uint1024) (q, r Uint1024, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
+	}
+	hi, lo := x.MulFull(y)
+	if z.Cmp(hi) <= 0 {
+		return Uint1024{}, Uint1024{}, false
+	}
+	q, r = div1024(hi, lo, z)
+	return q, r, true
+}
 
-	z := x
-	v := yv[len(yv)-1]
-	s := bits.LeadingZeros64(v) + 1
-	v <<= s
-	w := 64 - s
-	for j := 0; j < w; j++ {
-		z = z.Mul(z)
-		if v&mask != 0 {
-			z = z.Mul(x)
-		}
-		if mod {
-			_, z = z.QuoRem(m)
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint1024) Exp(y, m Uint1024) Uint1024 {
+	switch {
+	case m.IsZero():
+		return x.exp(y)
+	case m == U1024From64(1):
+		return Uint1024{}
+	case m.u0&1 != 0:
+		// NewMont1024 only fails for even moduli.
+		c, _ := NewMont1024(m)
+		return c.Exp(x, y)
+	}
+
+	z := U1024From64(1)
+	x = x.mod(m)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.SqrMod(m)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.MulMod(x, m)
 		}
-		v <<= 1
 	}
+	return z
+}
 
-	for i := len(yv) - 2; i >= 0; i-- {
-		v := yv[i]
-		for j := 0; j < 64; j++ {
-			z = z.Mul(z)
-			if v&mask != 0 {
-				z = z.Mul(x)
-			}
-			if mod {
-				_, z = z.QuoRem(m)
-			}
-			v <<= 1
+// exp returns x^y.
+func (x Uint1024) exp(y Uint1024) Uint1024 {
+	z := U1024From64(1)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.Mul(z)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.Mul(x)
 		}
 	}
 	return z
@@ -1407,8 +1778,10 @@ func (x Uint1024) mulPow10(n uint) (Uint1024, bool) {
 		return Uint1024{}, true
 	case n == 0:
 		return x, true
+	case n >= 309:
+		return Uint1024{}, false
 	default:
-		return x.MulCheck(U1024From64(10).Exp(U1024From64(uint64(n)), U1024From64(0)))
+		return x.MulCheck(U1024From64(10).exp(U1024From64(uint64(n))))
 	}
 }
 
@@ -1419,11 +1792,10 @@ var pow10tabUint1024 struct {
 
 func pow10Uint1024(n uint) Uint1024 {
 	pow10tabUint1024.once.Do(func() {
-		tab := make([]Uint1024, 2+309)
-		tab[0] = Uint1024{}
-		tab[1] = U1024From64(1)
-		for i := 2; i < len(tab); i++ {
-			tab[i] = tab[i-1].mul64(10)
+		tab := make([]Uint1024, 309)
+		tab[0] = U1024From64(1)
+		for i := 1; i < len(tab); i++ {
+			tab[i] = tab[i-1].Mul64(10)
 		}
 		pow10tabUint1024.values = tab
 	})
@@ -1433,7 +1805,10 @@ func pow10Uint1024(n uint) Uint1024 {
 // MulCheck returns x*y and reports whether the multiplication
 // oveflowed.
 func (x Uint1024) MulCheck(y Uint1024) (Uint1024, bool) {
-	if x.BitLen()+y.BitLen() > 1024 {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > 1024+1 {
 		return Uint1024{}, false
 	}
 
@@ -1691,7 +2066,9 @@ func (x Uint1024) MulCheck(y Uint1024) (Uint1024, bool) {
 	return z, true
 }
 
-func (x Uint1024) mulCheck64(y uint64) (Uint1024, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint1024) MulCheck64(y uint64) (Uint1024, bool) {
 	if y == 0 {
 		return Uint1024{}, true
 	}
@@ -1719,6 +2096,89 @@ func (x Uint1024) mulCheck64(y uint64) (Uint1024, bool) {
 	return z, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint1024 if x+y
+// overflows.
+func (x Uint1024) SaturatingAdd(y Uint1024) Uint1024 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint1024) SaturatingSub(y Uint1024) Uint1024 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint1024{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint1024 if x*y
+// overflows.
+func (x Uint1024) SaturatingMul(y Uint1024) Uint1024 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint1024 if any set
+// bits are shifted out.
+func (x Uint1024) SaturatingLsh(n uint) Uint1024 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint1024) Quo(y Uint1024) Uint1024 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint1024) Rem(y Uint1024) Uint1024 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint1024) QuoCeil(y Uint1024) Uint1024 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint1024) QuoRound(y Uint1024, mode RoundingMode) Uint1024 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint1024.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint1024) QuoRemCheck(y Uint1024) (q, r Uint1024, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -1741,13 +2201,13 @@ func (x Uint1024) QuoRem(y Uint1024) (q, r Uint1024) {
 	tq, _ := div512(x1.high(), x1.low(), y1.high())
 	tq = tq.Rsh(511 - n) // tq >>= 511 - n
 	if !tq.IsZero() {
-		tq = tq.sub64(1) // tq--
+		tq = tq.Sub64(1) // tq--
 	}
 	q = u1024(tq, Uint512{})
 	ytq := y.mul512(tq) // ytq := y*tq
 	r = x.Sub(ytq)      // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
@@ -1767,11 +2227,11 @@ func (x Uint1024) quoRem512(y Uint512) (q Uint1024, r Uint512) {
 	return u1024(lo, hi), r
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint1024) quoRem64(y uint64) (q Uint1024, r uint64) {
+func (x Uint1024) QuoRem64(y uint64) (q Uint1024, r uint64) {
 	q.u15, r = bits.Div64(0, x.u15, y)
 	q.u14, r = bits.Div64(r, x.u14, y)
 	q.u13, r = bits.Div64(r, x.u13, y)
@@ -1818,7 +2278,7 @@ func div1024(hi, lo, y Uint1024) (q, r Uint1024) {
 
 	// for q1 >= two512 || q1*yn0 > two512*rhat+un1 { ... }
 	for !q1.high().IsZero() || q1.mul512(yn0).Cmp(u1024(un1, rhat)) > 0 {
-		q1 = q1.sub64(1)             // q1--
+		q1 = q1.Sub64(1)             // q1--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -1831,7 +2291,7 @@ func div1024(hi, lo, y Uint1024) (q, r Uint1024) {
 
 	// for q0 >= two512 || q0*yn0 > two512*rhat+un0 { ... }
 	for !q0.high().IsZero() || q0.mul512(yn0).Cmp(u1024(un0, rhat)) > 0 {
-		q0 = q0.sub64(1)             // q0--
+		q0 = q0.Sub64(1)             // q0--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -1845,8 +2305,24 @@ func div1024(hi, lo, y Uint1024) (q, r Uint1024) {
 	return
 }
 
+// DivFull1024 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull1024 panics for y == 0 (division by zero) or
+// y <= hi (quotient overflow).
+//
+// It is the 1024-bit analogue of [bits.Div64].
+func DivFull1024(hi, lo, y Uint1024) (q, r Uint1024) {
+	return div1024(hi, lo, y)
+}
+
+// GoString returns x as a call to [U1024] that produces x.
 func (x Uint1024) GoString() string {
-	return fmt.Sprintf("[%d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d]",
+	return fmt.Sprintf("fixed.U1024(%#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x)",
 		x.u0,
 		x.u1,
 		x.u2,
@@ -1866,19 +2342,98 @@ func (x Uint1024) GoString() string {
 	)
 }
 
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U1024].
+func (x Uint1024) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint1024) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint1024) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint1024) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
+}
+
 // String returns the base-10 representation of x.
 func (x Uint1024) String() string {
-	b := make([]byte, 309)
+	var b [309]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint1024) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint1024) AppendDecimal(dst []byte) []byte {
+	var b [309]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint1024.
+func (x Uint1024) FormatInto(b *[309]byte) []byte {
 	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint1024 returns the value of s in the given base.
@@ -1886,3 +2441,118 @@ func ParseUint1024(s string, base int) (Uint1024, error) {
 	x, _, _, err := parseUint[Uint1024](s, base, false)
 	return x, err
 }
+
+// Mont1024 is a Montgomery arithmetic context for an odd
+// 1024-bit modulus.
+//
+// Values in Montgomery form are represented as x*R mod m, where
+// R = 2^1024.
+//
+// Mont1024 is not constant time and should not be used with
+// secret exponents.
+type Mont1024 struct {
+	m   [16]uint64
+	r2  Uint1024 // R^2 mod m
+	one Uint1024 // R mod m
+	k   uint64   // -m^-1 mod 2^64
+}
+
+var _ Mont[Uint1024] = (*Mont1024)(nil)
+
+// NewMont1024 creates a Montgomery context for the odd modulus
+// m.
+func NewMont1024(m Uint1024) (*Mont1024, error) {
+	if m.u0&1 == 0 {
+		return nil, errEvenModulus
+	}
+	_, one := Uint1024{}.Sub(m).QuoRem(m) // 2^1024 mod m
+	r2 := one
+	for i := 0; i < 1024; i++ {
+		// r2 = 2*r2 mod m
+		var c uint64
+		r2, c = r2.AddCheck(r2)
+		if c != 0 || r2.Cmp(m) >= 0 {
+			r2 = r2.Sub(m)
+		}
+	}
+	return &Mont1024{
+		m:   m.limbs(),
+		r2:  r2,
+		one: one,
+		k:   montK(m.u0),
+	}, nil
+}
+
+// Modulus returns the modulus m.
+func (c *Mont1024) Modulus() Uint1024 {
+	return u1024FromLimbs(c.m)
+}
+
+// ToMont converts x to Montgomery form.
+func (c *Mont1024) ToMont(x Uint1024) Uint1024 {
+	if m := c.Modulus(); x.Cmp(m) >= 0 {
+		_, x = x.QuoRem(m)
+	}
+	return c.MulMont(x, c.r2)
+}
+
+// FromMont converts x from Montgomery form.
+func (c *Mont1024) FromMont(x Uint1024) Uint1024 {
+	return c.MulMont(x, U1024From64(1))
+}
+
+// MulMont returns x*y*R^-1 mod m.
+//
+// Both x and y must be less than m. If x and y are in
+// Montgomery form, then so is the result.
+func (c *Mont1024) MulMont(x, y Uint1024) Uint1024 {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [16]uint64
+	var t [16 + 2]uint64
+	montMul(z[:], xv[:], yv[:], c.m[:], t[:], c.k)
+	return u1024FromLimbs(z)
+}
+
+// ExpMont returns x^y mod m, where x and the result are in
+// Montgomery form.
+//
+// x must be less than m.
+func (c *Mont1024) ExpMont(x, y Uint1024) Uint1024 {
+	// Fixed 4-bit window.
+	var tab [16]Uint1024
+	tab[0] = c.one
+	tab[1] = x
+	for i := 2; i < len(tab); i++ {
+		tab[i] = c.MulMont(tab[i-1], x)
+	}
+
+	z := c.one
+	started := false
+	yv := y.limbs()
+	for i := len(yv) - 1; i >= 0; i-- {
+		for s := 60; s >= 0; s -= 4 {
+			w := (yv[i] >> s) & 15
+			if !started {
+				if w != 0 {
+					z = tab[w]
+					started = true
+				}
+				continue
+			}
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			if w != 0 {
+				z = c.MulMont(z, tab[w])
+			}
+		}
+	}
+	return z
+}
+
+// Exp returns x^y mod m.
+func (c *Mont1024) Exp(x, y Uint1024) Uint1024 {
+	return c.FromMont(c.ExpMont(c.ToMont(x), y))
+}
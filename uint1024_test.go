@@ -180,6 +180,23 @@ func TestUint1024Cmp(t *testing.T) {
 	}
 }
 
+func TestUint1024Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint1024()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint1024And(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint1024()
@@ -295,7 +312,7 @@ func TestUint1024Add64(t *testing.T) {
 		x := randUint1024()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -305,9 +322,9 @@ func TestUint1024Add64(t *testing.T) {
 		}
 		want.And(want, big1024mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -346,7 +363,7 @@ func TestUint1024Sub64(t *testing.T) {
 		x := randUint1024()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -356,9 +373,9 @@ func TestUint1024Sub64(t *testing.T) {
 		}
 		want.And(want, big1024mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -398,7 +415,7 @@ func TestUint1024Mul64(t *testing.T) {
 		x := randUint1024()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -408,11 +425,11 @@ func TestUint1024Mul64(t *testing.T) {
 		}
 		want.And(want, big1024mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -424,7 +441,7 @@ func TestUint1024Exp(t *testing.T) {
 	x := U1024From64(1)
 	ten := U1024From64(10)
 	for i := 1; ; i++ {
-		want, ok := x.mulCheck64(10)
+		want, ok := x.MulCheck64(10)
 		if !ok {
 			break
 		}
@@ -436,6 +453,126 @@ func TestUint1024Exp(t *testing.T) {
 	}
 }
 
+func TestUint1024ExpMod(t *testing.T) {
+	for i := 0; i < 64; i++ {
+		x := randUint1024()
+		y := randUint1024()
+		m := randUint1024()
+		if m.IsZero() {
+			m = U1024From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint1024MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint1024()
+		y := randUint1024()
+		m := randUint1024()
+		if m.IsZero() {
+			m = U1024From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint1024AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint1024()
+		y := randUint1024()
+		m := randUint1024()
+		if m.IsZero() {
+			m = U1024From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint1024MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 1024), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint1024()
+		y := randUint1024()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 1024)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull1024(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint1024()
+		lo := randUint1024()
+		y := randUint1024()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull1024(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 1024)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint1024MulDiv(t *testing.T) {
+	testMulDiv(t, randUint1024)
+}
+
+func TestUint1024Quo(t *testing.T) {
+	testQuo(t, randUint1024)
+}
+
+func TestUint1024Saturating(t *testing.T) {
+	testSaturating(t, randUint1024)
+}
+
+func TestUint1024MulPow10(t *testing.T) {
+	testMulPow10(t, randUint1024)
+}
+
 func TestUint1024QuoRem(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint1024()
@@ -496,7 +633,7 @@ func TestUint1024QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -548,6 +685,70 @@ func TestParseUint1024(t *testing.T) {
 	}
 }
 
+func randMont1024() *Mont1024 {
+	m := randUint1024()
+	m.u0 |= 1
+	c, err := NewMont1024(m)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func TestMont1024Mul(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		c := randMont1024()
+		m := c.Modulus()
+		x := randUint1024()
+		y := randUint1024()
+
+		z := c.FromMont(c.MulMont(c.ToMont(x), c.ToMont(y)))
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestMont1024Exp(t *testing.T) {
+	for i := 0; i < 64; i++ {
+		c := randMont1024()
+		m := c.Modulus()
+		x := randUint1024()
+		y := randUint1024()
+
+		z := c.Exp(x, y)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestNewMont1024Even(t *testing.T) {
+	for _, m := range []Uint1024{{}, U1024From64(2), Uint1024{}.max().Sub(U1024From64(1))} {
+		if _, err := NewMont1024(m); err == nil {
+			t.Fatalf("%d: expected an error", m.big())
+		}
+	}
+}
+
+func BenchmarkMont1024Exp(b *testing.B) {
+	c := randMont1024()
+	x := c.ToMont(randUint1024())
+	y := Uint1024{}.max()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink.Uint1024 = c.ExpMont(x, y)
+	}
+}
+
 func BenchmarkUint1024Add(b *testing.B) {
 	s := make([]Uint1024, 1000)
 	for i := range s {
@@ -598,6 +799,6 @@ func BenchmarkUint1024QuoRem(b *testing.B) {
 
 func BenchmarkUint1024QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.Uint1024, sink.uint64 = U1024From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.Uint1024, sink.uint64 = U1024From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
@@ -1,9 +1,11 @@
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"strconv"
 )
@@ -36,6 +38,15 @@ func U128From64(x uint64) Uint128 {
 	return Uint128{u0: x}
 }
 
+// U128FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint128].
+func U128FromFloat64(f float64, mode RoundingMode) (Uint128, error) {
+	return fromFloat64[Uint128](f, mode)
+}
+
 func (Uint128) max() Uint128 {
 	return Uint128{
 		math.MaxUint64,
@@ -56,6 +67,11 @@ func (x Uint128) uint8() uint8 {
 	return byte(x.u0)
 }
 
+//lint:ignore U1000 used by [Uint].
+func (x Uint128) uint64() uint64 {
+	return x.u0
+}
+
 // Bytes encodes x as a little-endian integer.
 func (x Uint128) Bytes(b *[16]byte) {
 	binary.LittleEndian.PutUint64(b[0:], x.u0)
@@ -72,6 +88,201 @@ func (x *Uint128) SetBytes(b []byte) error {
 	return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint128) BigEndianBytes(b *[16]byte) {
+	binary.BigEndian.PutUint64(b[0:], x.u1)
+	binary.BigEndian.PutUint64(b[8:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint128) SetBigEndianBytes(b []byte) error {
+	if len(b) != 16 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u1 = binary.BigEndian.Uint64(b[0:])
+	x.u0 = binary.BigEndian.Uint64(b[8:])
+	return nil
+}
+
+// AppendBytes appends the 16-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint128) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint64(dst, x.u1)
+		return dst
+	}
+	dst = order.AppendUint64(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint128) FillBytes(buf []byte) []byte {
+	var b [16]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 16 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 16 bytes.
+func (x *Uint128) SetBytesPadded(b []byte) error {
+	if len(b) > 16 {
+		return ErrOverflow
+	}
+	var be [16]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 16 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint128) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 16)
+	_ = x.SetBytesPadded(b) // len(b) <= 16
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint128) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint128) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint128.Bytes].
+func (x Uint128) MarshalBinary() ([]byte, error) {
+	var b [16]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint128.SetBytes].
+func (x *Uint128) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint128) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint128) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint128) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint128) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint128].
+func (x *Uint128) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint128) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint128) FillBig(z *big.Int) *big.Int {
+	v := [2]uint64{x.u0, x.u1}
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^128 and reports whether v is
+// negative or does not fit in 128 bits.
+func (x *Uint128) SetBig(v *big.Int) (overflow bool) {
+	var z [2]uint64
+	overflow = setBig(z[:], v, 128)
+	*x = Uint128{z[0], z[1]}
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint128) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^128, and reports whether the rounded value is
+// negative or does not fit in 128 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint128) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint128{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint128) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint128) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint128) Size() int {
 	return 128
@@ -111,12 +322,12 @@ func (x Uint128) Cmp(y Uint128) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint128) cmp64(y uint64) int {
+func (x Uint128) Cmp64(y uint64) int {
 	if x.u1 != 0 {
 		return +1
 	}
@@ -181,8 +392,8 @@ func (x Uint128) Add(y Uint128) Uint128 {
 	return z
 }
 
-// add64 returns x+y.
-func (x Uint128) add64(y uint64) Uint128 {
+// Add64 returns x+y.
+func (x Uint128) Add64(y uint64) Uint128 {
 	u0, c := bits.Add64(x.u0, y, 0)
 	u1, _ := bits.Add64(x.u1, 0, c)
 	return Uint128{u0, u1}
@@ -197,10 +408,10 @@ func (x Uint128) AddCheck(y Uint128) (z Uint128, carry uint64) {
 	return Uint128{u0, u1}, c
 }
 
-// add64 returns x+y.
+// Add64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint128) addCheck64(y uint64) (z Uint128, carry uint64) {
+func (x Uint128) AddCheck64(y uint64) (z Uint128, carry uint64) {
 	u0, c := bits.Add64(x.u0, y, 0)
 	u1, c := bits.Add64(x.u1, 0, c)
 	return Uint128{u0, u1}, c
@@ -213,7 +424,8 @@ func (x Uint128) Sub(y Uint128) Uint128 {
 	return Uint128{u0, u1}
 }
 
-func (x Uint128) sub64(y uint64) Uint128 {
+// Sub64 returns x-y.
+func (x Uint128) Sub64(y uint64) Uint128 {
 	u0, b := bits.Sub64(x.u0, y, 0)
 	u1, _ := bits.Sub64(x.u1, 0, b)
 	return Uint128{u0, u1}
@@ -228,7 +440,10 @@ func (x Uint128) SubCheck(y Uint128) (z Uint128, borrow uint64) {
 	return Uint128{u0, u1}, b
 }
 
-func (x Uint128) subCheck64(y uint64) (z Uint128, borrow uint64) {
+// SubCheck64 returns x-y.
+//
+// borrow is 1 if x-y underflows and 0 otherwise.
+func (x Uint128) SubCheck64(y uint64) (z Uint128, borrow uint64) {
 	u0, b := bits.Sub64(x.u0, y, 0)
 	u1, b := bits.Sub64(x.u1, 0, b)
 	return Uint128{u0, u1}, b
@@ -243,7 +458,10 @@ func (x Uint128) Mul(y Uint128) Uint128 {
 // MulCheck returns x*y and reports whether the multiplication
 // oveflowed.
 func (x Uint128) MulCheck(y Uint128) (Uint128, bool) {
-	if x.BitLen()+y.BitLen() > 128 {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > 128+1 {
 		return Uint128{}, false
 	}
 
@@ -269,12 +487,15 @@ func (x Uint128) MulCheck(y Uint128) (Uint128, bool) {
 	return Uint128{u0, u1}, true
 }
 
-func (x Uint128) mul64(y uint64) Uint128 {
+// Mul64 returns x*y.
+func (x Uint128) Mul64(y uint64) Uint128 {
 	hi, lo := bits.Mul64(x.u0, y)
 	return Uint128{lo, hi + x.u1*y}
 }
 
-func (x Uint128) mulCheck64(y uint64) (Uint128, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint128) MulCheck64(y uint64) (Uint128, bool) {
 	if y == 0 {
 		return Uint128{}, true
 	}
@@ -286,6 +507,89 @@ func (x Uint128) mulCheck64(y uint64) (Uint128, bool) {
 	return Uint128{u0, u1}, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint128 if x+y
+// overflows.
+func (x Uint128) SaturatingAdd(y Uint128) Uint128 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint128) SaturatingSub(y Uint128) Uint128 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint128{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint128 if x*y
+// overflows.
+func (x Uint128) SaturatingMul(y Uint128) Uint128 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint128 if any set
+// bits are shifted out.
+func (x Uint128) SaturatingLsh(n uint) Uint128 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint128) Quo(y Uint128) Uint128 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint128) Rem(y Uint128) Uint128 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint128) QuoCeil(y Uint128) Uint128 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint128) QuoRound(y Uint128, mode RoundingMode) Uint128 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint128.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint128) QuoRemCheck(y Uint128) (q, r Uint128, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -298,7 +602,7 @@ func (x Uint128) QuoRem(y Uint128) (q, r Uint128) {
 	}
 	if y.u1 == 0 {
 		// Fast path for a 64-bit y.
-		q, r64 := x.quoRem64(y.u0)
+		q, r64 := x.QuoRem64(y.u0)
 		return q, U128From64(r64)
 	}
 
@@ -311,20 +615,20 @@ func (x Uint128) QuoRem(y Uint128) (q, r Uint128) {
 		tq--
 	}
 	q = U128From64(tq)
-	ytq := y.mul64(tq) // ytq := y*tq
+	ytq := y.Mul64(tq) // ytq := y*tq
 	r = x.Sub(ytq)     // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint128) quoRem64(y uint64) (q Uint128, r uint64) {
+func (x Uint128) QuoRem64(y uint64) (q Uint128, r uint64) {
 	if x.u1 < y {
 		lo, r := bits.Div64(x.u1, x.u0, y)
 		return Uint128{lo, 0}, r
@@ -355,13 +659,13 @@ func div128(hi, lo, y Uint128) (q, r Uint128) {
 	un10 := lo.Lsh(s)                     // un10 := lo<<s
 	un1 := un10.u1                        // un1 := un10 >> 64
 	un0 := un10.u0                        // un0 := un10 & mask64
-	q1, rhat := un32.quoRem64(yn1)
+	q1, rhat := un32.QuoRem64(yn1)
 
 	var c uint64 // rhat + yn1 carry
 
 	// for q1 >= two64 || q1*yn0 > two64*rhat+un1 { ... }
-	for q1.u1 != 0 || q1.mul64(yn0).Cmp(Uint128{un1, rhat}) > 0 {
-		q1 = q1.sub64(1)                   // q1--
+	for q1.u1 != 0 || q1.Mul64(yn0).Cmp(Uint128{un1, rhat}) > 0 {
+		q1 = q1.Sub64(1)                   // q1--
 		rhat, c = bits.Add64(rhat, yn1, 0) // rhat += yn1
 		if c != 0 {
 			break
@@ -370,11 +674,11 @@ func div128(hi, lo, y Uint128) (q, r Uint128) {
 
 	// un21 := un32*two64 + un1 - q1*y
 	un21 := Uint128{un1, un32.u0}.Sub(q1.Mul(y))
-	q0, rhat := un21.quoRem64(yn1)
+	q0, rhat := un21.QuoRem64(yn1)
 
 	// for q0 >= two64 || q0*yn0 > two64*rhat+un0 { ... }
-	for q0.u1 != 0 || q0.mul64(yn0).Cmp(Uint128{un0, rhat}) > 0 {
-		q0 = q0.sub64(1)                   // q0--
+	for q0.u1 != 0 || q0.Mul64(yn0).Cmp(Uint128{un0, rhat}) > 0 {
+		q0 = q0.Sub64(1)                   // q0--
 		rhat, c = bits.Add64(rhat, yn1, 0) // rhat += yn1
 		if c != 0 {
 			break
@@ -388,26 +692,277 @@ func div128(hi, lo, y Uint128) (q, r Uint128) {
 	return
 }
 
+// DivFull128 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull128 panics for y == 0 (division by zero) or y <= hi
+// (quotient overflow).
+//
+// It is the 128-bit analogue of [bits.Div64].
+func DivFull128(hi, lo, y Uint128) (q, r Uint128) {
+	return div128(hi, lo, y)
+}
+
+// MulFull returns the full 256-bit product x*y as (hi, lo).
+//
+// It is the 128-bit analogue of [bits.Mul64].
+func (x Uint128) MulFull(y Uint128) (hi, lo Uint128) {
+	h00, l00 := bits.Mul64(x.u0, y.u0)
+	h01, l01 := bits.Mul64(x.u0, y.u1)
+	h10, l10 := bits.Mul64(x.u1, y.u0)
+	h11, l11 := bits.Mul64(x.u1, y.u1)
+
+	t, c1 := bits.Add64(h00, l01, 0)
+	u1, c2 := bits.Add64(t, l10, 0)
+	t, c3 := bits.Add64(h01, h10, 0)
+	t, c4 := bits.Add64(t, l11, 0)
+	u2, c5 := bits.Add64(t, c1+c2, 0)
+	u3 := h11 + c3 + c4 + c5
+	return Uint128{u2, u3}, Uint128{l00, u1}
+}
+
+// mod returns x mod m.
+func (x Uint128) mod(m Uint128) Uint128 {
+	if x.Cmp(m) < 0 {
+		return x
+	}
+	_, r := x.QuoRem(m)
+	return r
+}
+
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint128) AddMod(y, m Uint128) Uint128 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
+	}
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint128) MulMod(y, m Uint128) Uint128 {
+	hi, lo := x.mod(m).MulFull(y.mod(m))
+	_, r := div128(hi, lo, m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint128) SqrMod(m Uint128) Uint128 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint128) MulDiv(y, z Uint128) (Uint128, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint128) MulDivRoundUp(y, z Uint128) (Uint128, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
+
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint128) MulDivRound(y, z Uint128, mode RoundingMode) (Uint128, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint128{}, false
+	}
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint128.
+func (x Uint128) mulDiv(y, z Uint128) (q, r Uint128, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
+	}
+	hi, lo := x.MulFull(y)
+	if z.Cmp(hi) <= 0 {
+		return Uint128{}, Uint128{}, false
+	}
+	q, r = div128(hi, lo, z)
+	return q, r, true
+}
+
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint128) Exp(y, m Uint128) Uint128 {
+	if m == U128From64(1) {
+		return Uint128{}
+	}
+	mod := !m.IsZero()
+	if mod {
+		x = x.mod(m)
+	}
+	z := U128From64(1)
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		if mod {
+			z = z.SqrMod(m)
+		} else {
+			z = z.Mul(z)
+		}
+		if y.Rsh(uint(i)).u0&1 != 0 {
+			if mod {
+				z = z.MulMod(x, m)
+			} else {
+				z = z.Mul(x)
+			}
+		}
+	}
+	return z
+}
+
+// mulPow10 returns x * 10^n.
+func (x Uint128) mulPow10(n uint) (Uint128, bool) {
+	switch {
+	case x.IsZero():
+		return Uint128{}, true
+	case n == 0:
+		return x, true
+	case n >= uint(len(pow10tab128)):
+		return Uint128{}, false
+	default:
+		return x.MulCheck(pow10tab128[n])
+	}
+}
+
+// GoString returns x as a call to [U128] that produces x.
 func (x Uint128) GoString() string {
-	return fmt.Sprintf("[%d %d]", x.u0, x.u1)
+	return fmt.Sprintf("fixed.U128(%#x, %#x)", x.u0, x.u1)
+}
+
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U128].
+func (x Uint128) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint128) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint128) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint128) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
 }
 
 // String returns the base-10 representation of x.
 func (x Uint128) String() string {
+	var b [39]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint128) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint128) AppendDecimal(dst []byte) []byte {
+	var b [39]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint128.
+func (x Uint128) FormatInto(b *[39]byte) []byte {
+	i := len(b)
 	if x.u1 == 0 {
-		return strconv.FormatUint(x.u0, 10)
+		var s [20]byte
+		d := strconv.AppendUint(s[:0], x.u0, 10)
+		i -= copy(b[i-len(d):], d)
+		return b[i:]
 	}
-	b := make([]byte, 39)
-	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint128 returns the value of s in the given base.
@@ -415,3 +970,45 @@ func ParseUint128(s string, base int) (Uint128, error) {
 	x, _, _, err := parseUint[Uint128](s, base, false)
 	return x, err
 }
+
+var pow10tab128 = [...]Uint128{
+	{1, 0},
+	{10, 0},
+	{100, 0},
+	{1000, 0},
+	{10000, 0},
+	{100000, 0},
+	{1000000, 0},
+	{10000000, 0},
+	{100000000, 0},
+	{1000000000, 0},
+	{10000000000, 0},
+	{100000000000, 0},
+	{1000000000000, 0},
+	{10000000000000, 0},
+	{100000000000000, 0},
+	{1000000000000000, 0},
+	{10000000000000000, 0},
+	{100000000000000000, 0},
+	{1000000000000000000, 0},
+	{10000000000000000000, 0},
+	{7766279631452241920, 5},
+	{3875820019684212736, 54},
+	{1864712049423024128, 542},
+	{200376420520689664, 5421},
+	{2003764205206896640, 54210},
+	{1590897978359414784, 542101},
+	{15908979783594147840, 5421010},
+	{11515845246265065472, 54210108},
+	{4477988020393345024, 542101086},
+	{7886392056514347008, 5421010862},
+	{5076944270305263616, 54210108624},
+	{13875954555633532928, 542101086242},
+	{9632337040368467968, 5421010862427},
+	{4089650035136921600, 54210108624275},
+	{4003012203950112768, 542101086242752},
+	{3136633892082024448, 5421010862427522},
+	{12919594847110692864, 54210108624275221},
+	{68739955140067328, 542101086242752217},
+	{687399551400673280, 5421010862427522170},
+}
@@ -70,6 +70,23 @@ func TestUint128Cmp(t *testing.T) {
 	}
 }
 
+func TestUint128Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint128()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint128Add(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint128()
@@ -100,7 +117,7 @@ func TestUint128Add64(t *testing.T) {
 		x := randUint128()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -110,9 +127,9 @@ func TestUint128Add64(t *testing.T) {
 		}
 		want.And(want, big128mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -151,7 +168,7 @@ func TestUint128Sub64(t *testing.T) {
 		x := randUint128()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -161,9 +178,9 @@ func TestUint128Sub64(t *testing.T) {
 		}
 		want.And(want, big128mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -203,7 +220,7 @@ func TestUint128Mul64(t *testing.T) {
 		x := randUint128()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -213,11 +230,11 @@ func TestUint128Mul64(t *testing.T) {
 		}
 		want.And(want, big128mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -251,6 +268,33 @@ func TestUint128QuoRem(t *testing.T) {
 	}
 }
 
+func TestUint128QuoRem64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint128()
+		y := randUint64()
+		if y == 0 {
+			y = 1
+		}
+
+		q, r := x.QuoRem64(y)
+
+		ybig := new(big.Int).SetUint64(y)
+		wantq := new(big.Int)
+		wantr := new(big.Int)
+		wantq.QuoRem(x.big(), ybig, wantr)
+		wantq.And(wantq, big128mask)
+
+		if got := q.big(); got.Cmp(wantq) != 0 {
+			t.Fatalf("%d / %d expected quotient of %d, got %d",
+				x.big(), ybig, wantq, got)
+		}
+		if got := new(big.Int).SetUint64(r); got.Cmp(wantr) != 0 {
+			t.Fatalf("%d / %d expected remainder of %d, got %d",
+				x.big(), ybig, wantr, got)
+		}
+	}
+}
+
 func TestUint128Lsh(t *testing.T) {
 	for i := 0; i < 1_000_000; i++ {
 		x := randUint128()
@@ -285,6 +329,142 @@ func TestUint128Rsh(t *testing.T) {
 	}
 }
 
+func TestUint128ExpMod(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		x := randUint128()
+		y := randUint128()
+		m := randUint128()
+		if m.IsZero() {
+			m = U128From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint128Exp(t *testing.T) {
+	mod := new(big.Int).Lsh(big.NewInt(1), 128)
+	for i := 0; i < 1000; i++ {
+		x := randUint128()
+		y := U128From64(uint64(rand.Intn(300)))
+
+		z := x.Exp(y, Uint128{})
+
+		want := new(big.Int).Exp(x.big(), y.big(), mod)
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d: expected %d, got %d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func TestUint128MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint128()
+		y := randUint128()
+		m := randUint128()
+		if m.IsZero() {
+			m = U128From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint128AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint128()
+		y := randUint128()
+		m := randUint128()
+		if m.IsZero() {
+			m = U128From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint128MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint128()
+		y := randUint128()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 128)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull128(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint128()
+		lo := randUint128()
+		y := randUint128()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull128(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 128)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint128MulDiv(t *testing.T) {
+	testMulDiv(t, randUint128)
+}
+
+func TestUint128Quo(t *testing.T) {
+	testQuo(t, randUint128)
+}
+
+func TestUint128Saturating(t *testing.T) {
+	testSaturating(t, randUint128)
+}
+
+func TestUint128MulPow10(t *testing.T) {
+	testMulPow10(t, randUint128)
+}
+
 func TestUint128String(t *testing.T) {
 	test := func(x Uint128) {
 		want := x.big().String()
@@ -334,6 +514,6 @@ func BenchmarkUint128Mul(b *testing.B) {
 
 func BenchmarkUint128QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.Uint128, sink.uint64 = U128From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.Uint128, sink.uint64 = U128From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
@@ -1,8 +1,11 @@
 package fixed
 
 import (
+	"database/sql/driver"
+	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 )
 
@@ -40,6 +43,15 @@ func U192From64(x uint64) Uint192 {
 	return Uint192{u0: x}
 }
 
+// U192FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint192].
+func U192FromFloat64(f float64, mode RoundingMode) (Uint192, error) {
+	return fromFloat64[Uint192](f, mode)
+}
+
 func (Uint192) max() Uint192 {
 	return Uint192{
 		math.MaxUint64,
@@ -58,6 +70,11 @@ func (x Uint192) low128() Uint128 {
 	return Uint128{x.u0, x.u1}
 }
 
+// uint256 returns x as a Uint256.
+func (x Uint192) uint256() Uint256 {
+	return Uint256{x.u0, x.u1, x.u2, 0}
+}
+
 // hi128 returns the high 128 bits in x.
 //
 // Since x is 192 bits, the high 64 bits in the result are always
@@ -71,6 +88,228 @@ func (x Uint192) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+//lint:ignore U1000 used by [Uint].
+func (x Uint192) uint64() uint64 {
+	return x.u0
+}
+
+// Bytes encodes x as a little-endian integer.
+func (x Uint192) Bytes(b *[24]byte) {
+	binary.LittleEndian.PutUint64(b[0:], x.u0)
+	binary.LittleEndian.PutUint64(b[8:], x.u1)
+	binary.LittleEndian.PutUint64(b[16:], x.u2)
+}
+
+// SetBytes sets x to the encoded little-endian integer b.
+func (x *Uint192) SetBytes(b []byte) error {
+	if len(b) != 24 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u0 = binary.LittleEndian.Uint64(b[0:])
+	x.u1 = binary.LittleEndian.Uint64(b[8:])
+	x.u2 = binary.LittleEndian.Uint64(b[16:])
+	return nil
+}
+
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint192) BigEndianBytes(b *[24]byte) {
+	binary.BigEndian.PutUint64(b[0:], x.u2)
+	binary.BigEndian.PutUint64(b[8:], x.u1)
+	binary.BigEndian.PutUint64(b[16:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint192) SetBigEndianBytes(b []byte) error {
+	if len(b) != 24 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u2 = binary.BigEndian.Uint64(b[0:])
+	x.u1 = binary.BigEndian.Uint64(b[8:])
+	x.u0 = binary.BigEndian.Uint64(b[16:])
+	return nil
+}
+
+// AppendBytes appends the 24-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint192) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint64(dst, x.u1)
+		dst = order.AppendUint64(dst, x.u2)
+		return dst
+	}
+	dst = order.AppendUint64(dst, x.u2)
+	dst = order.AppendUint64(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint192) FillBytes(buf []byte) []byte {
+	var b [24]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 24 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 24 bytes.
+func (x *Uint192) SetBytesPadded(b []byte) error {
+	if len(b) > 24 {
+		return ErrOverflow
+	}
+	var be [24]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 24 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint192) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 24)
+	_ = x.SetBytesPadded(b) // len(b) <= 24
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint192) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint192) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint192.Bytes].
+func (x Uint192) MarshalBinary() ([]byte, error) {
+	var b [24]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint192.SetBytes].
+func (x *Uint192) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint192) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint192) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint192) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint192) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint192].
+func (x *Uint192) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint192) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint192) FillBig(z *big.Int) *big.Int {
+	v := [3]uint64{x.u0, x.u1, x.u2}
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^192 and reports whether v is
+// negative or does not fit in 192 bits.
+func (x *Uint192) SetBig(v *big.Int) (overflow bool) {
+	var z [3]uint64
+	overflow = setBig(z[:], v, 192)
+	*x = Uint192{z[0], z[1], z[2]}
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint192) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^192, and reports whether the rounded value is
+// negative or does not fit in 192 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint192) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint192{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint192) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint192) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint192) Size() int {
 	return 192
@@ -116,12 +355,12 @@ func (x Uint192) Cmp(y Uint192) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint192) cmp64(y uint64) int {
+func (x Uint192) Cmp64(y uint64) int {
 	if x.u2 != 0 || x.u1 != 0 {
 		return +1
 	}
@@ -216,8 +455,8 @@ func (x Uint192) Add(y Uint192) Uint192 {
 	return Uint192{u0, u1, u2}
 }
 
-// add64 returns x+y.
-func (x Uint192) add64(y uint64) Uint192 {
+// Add64 returns x+y.
+func (x Uint192) Add64(y uint64) Uint192 {
 	u0, c := bits.Add64(x.u0, y, 0)
 	u1, c := bits.Add64(x.u1, 0, c)
 	u2, _ := bits.Add64(x.u2, 0, c)
@@ -234,10 +473,10 @@ func (x Uint192) AddCheck(y Uint192) (z Uint192, carry uint64) {
 	return Uint192{u0, u1, u2}, c
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint192) addCheck64(y uint64) (z Uint192, carry uint64) {
+func (x Uint192) AddCheck64(y uint64) (z Uint192, carry uint64) {
 	u0, c := bits.Add64(x.u0, y, 0)
 	u1, c := bits.Add64(x.u1, 0, c)
 	u2, c := bits.Add64(x.u2, 0, c)
@@ -252,7 +491,8 @@ func (x Uint192) Sub(y Uint192) Uint192 {
 	return Uint192{u0, u1, u2}
 }
 
-func (x Uint192) sub64(y uint64) Uint192 {
+// Sub64 returns x-y.
+func (x Uint192) Sub64(y uint64) Uint192 {
 	u0, b := bits.Sub64(x.u0, y, 0)
 	u1, b := bits.Sub64(x.u1, 0, b)
 	u2, _ := bits.Sub64(x.u2, 0, b)
@@ -269,7 +509,10 @@ func (x Uint192) SubCheck(y Uint192) (z Uint192, borrow uint64) {
 	return Uint192{u0, u1, u2}, b
 }
 
-func (x Uint192) subCheck64(y uint64) (z Uint192, borrow uint64) {
+// SubCheck64 returns x-y.
+//
+// borrow is 1 if x-y underflows and 0 otherwise.
+func (x Uint192) SubCheck64(y uint64) (z Uint192, borrow uint64) {
 	u0, b := bits.Sub64(x.u0, y, 0)
 	u1, b := bits.Sub64(x.u1, 0, b)
 	u2, b := bits.Sub64(x.u2, 0, b)
@@ -300,7 +543,8 @@ func (x Uint192) Mul(y Uint192) Uint192 {
 	return Uint192{u0, u1, u2}
 }
 
-func (x Uint192) mul64(y uint64) Uint192 {
+// Mul64 returns x*y.
+func (x Uint192) Mul64(y uint64) Uint192 {
 	if y == 0 {
 		return Uint192{}
 	}
@@ -333,7 +577,10 @@ func (x Uint192) mul128(y Uint128) Uint192 {
 // MulCheck returns x*y and indicates whether the multiplication
 // overflowed.
 func (x Uint192) MulCheck(y Uint192) (Uint192, bool) {
-	if x.BitLen()+y.BitLen() > 192 {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > 192+1 {
 		return Uint192{}, false
 	}
 
@@ -369,7 +616,9 @@ func (x Uint192) MulCheck(y Uint192) (Uint192, bool) {
 	return Uint192{u0, u1, u2}, true
 }
 
-func (x Uint192) mulCheck64(y uint64) (Uint192, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint192) MulCheck64(y uint64) (Uint192, bool) {
 	// TODO(eric): make this inlinable.
 	if y == 0 {
 		return Uint192{}, true
@@ -383,6 +632,89 @@ func (x Uint192) mulCheck64(y uint64) (Uint192, bool) {
 	return Uint192{u0, u1, u2}, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint192 if x+y
+// overflows.
+func (x Uint192) SaturatingAdd(y Uint192) Uint192 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint192) SaturatingSub(y Uint192) Uint192 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint192{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint192 if x*y
+// overflows.
+func (x Uint192) SaturatingMul(y Uint192) Uint192 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint192 if any set
+// bits are shifted out.
+func (x Uint192) SaturatingLsh(n uint) Uint192 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint192) Quo(y Uint192) Uint192 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint192) Rem(y Uint192) Uint192 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint192) QuoCeil(y Uint192) Uint192 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint192) QuoRound(y Uint192, mode RoundingMode) Uint192 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint192.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint192) QuoRemCheck(y Uint192) (q, r Uint192, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -396,7 +728,7 @@ func (x Uint192) QuoRem(y Uint192) (q, r Uint192) {
 	if y.u2 == 0 {
 		if y.u1 == 0 {
 			// Fast path for a 64-bit y.
-			q, r64 := x.quoRem64(y.u0)
+			q, r64 := x.QuoRem64(y.u0)
 			return q, U192From64(r64)
 		}
 		// Fast path for a 128-bit y.
@@ -410,23 +742,23 @@ func (x Uint192) QuoRem(y Uint192) (q, r Uint192) {
 	tq, _ := div128(x1.hi128(), x1.low128(), y1.hi128())
 	tq = tq.Rsh(127 - n) // tq >>= 127 - n
 	if !tq.IsZero() {
-		tq = tq.sub64(1) // tq--
+		tq = tq.Sub64(1) // tq--
 	}
 	q = tq.uint192()
 	ytq := y.mul128(tq) // ytq := y*tq
 	r = x.Sub(ytq)      // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint192) quoRem64(y uint64) (q Uint192, r uint64) {
+func (x Uint192) QuoRem64(y uint64) (q Uint192, r uint64) {
 	u2, r := bits.Div64(0, x.u2, y)
 	u1, r := bits.Div64(r, x.u1, y)
 	u0, r := bits.Div64(r, x.u0, y)
@@ -456,26 +788,283 @@ func (x Uint192) quoRem128(y Uint128) (q Uint192, r Uint128) {
 	return Uint192{lo.u0, lo.u1, hi.u0}, r
 }
 
+// DivFull192 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull192 panics for y == 0 (division by zero) or
+// y <= hi (quotient overflow).
+//
+// It is the 192-bit analogue of [bits.Div64].
+func DivFull192(hi, lo, y Uint192) (q, r Uint192) {
+	if y.IsZero() {
+		panic("integer divide by zero")
+	}
+	if y.Cmp(hi) <= 0 {
+		panic("integer overflow")
+	}
+	// Widen the 384-bit dividend to a (Uint256, Uint256) pair.
+	// Its high half is hi>>64 < y, so div256 cannot overflow.
+	q256, r256 := div256(
+		Uint256{hi.u1, hi.u2, 0, 0},
+		Uint256{lo.u0, lo.u1, lo.u2, hi.u0},
+		y.uint256(),
+	)
+	return Uint192{q256.u0, q256.u1, q256.u2}, Uint192{r256.u0, r256.u1, r256.u2}
+}
+
+// MulFull returns the full 384-bit product x*y as (hi, lo).
+//
+// It is the 192-bit analogue of [bits.Mul64].
+func (x Uint192) MulFull(y Uint192) (hi, lo Uint192) {
+	// The 384-bit product always fits in a (Uint256, Uint256)
+	// pair.
+	h, l := x.uint256().MulFull(y.uint256())
+	return Uint192{l.u3, h.u0, h.u1}, Uint192{l.u0, l.u1, l.u2}
+}
+
+// mod returns x mod m.
+func (x Uint192) mod(m Uint192) Uint192 {
+	if x.Cmp(m) < 0 {
+		return x
+	}
+	_, r := x.QuoRem(m)
+	return r
+}
+
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint192) AddMod(y, m Uint192) Uint192 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
+	}
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint192) MulMod(y, m Uint192) Uint192 {
+	// The 384-bit product always fits in a (Uint256, Uint256)
+	// pair.
+	hi, lo := x.mod(m).uint256().MulFull(y.mod(m).uint256())
+	_, r := div256(hi, lo, m.uint256())
+	return Uint192{r.u0, r.u1, r.u2}
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint192) SqrMod(m Uint192) Uint192 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint192) MulDiv(y, z Uint192) (Uint192, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint192) MulDivRoundUp(y, z Uint192) (Uint192, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
+
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint192) MulDivRound(y, z Uint192, mode RoundingMode) (Uint192, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint192{}, false
+	}
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint192.
+func (x Uint192) mulDiv(y, z Uint192) (q, r Uint192, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
+	}
+	// The 384-bit product always fits in a (Uint256, Uint256)
+	// pair.
+	hi, lo := x.uint256().MulFull(y.uint256())
+	if z.uint256().Cmp(hi) <= 0 {
+		return Uint192{}, Uint192{}, false
+	}
+	q256, r256 := div256(hi, lo, z.uint256())
+	if q256.u3 != 0 {
+		return Uint192{}, Uint192{}, false
+	}
+	return Uint192{q256.u0, q256.u1, q256.u2}, Uint192{r256.u0, r256.u1, r256.u2}, true
+}
+
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint192) Exp(y, m Uint192) Uint192 {
+	if m == U192From64(1) {
+		return Uint192{}
+	}
+	mod := !m.IsZero()
+	if mod {
+		x = x.mod(m)
+	}
+	z := U192From64(1)
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		if mod {
+			z = z.SqrMod(m)
+		} else {
+			z = z.Mul(z)
+		}
+		if y.Rsh(uint(i)).u0&1 != 0 {
+			if mod {
+				z = z.MulMod(x, m)
+			} else {
+				z = z.Mul(x)
+			}
+		}
+	}
+	return z
+}
+
+// mulPow10 returns x * 10^n.
+func (x Uint192) mulPow10(n uint) (Uint192, bool) {
+	switch {
+	case x.IsZero():
+		return Uint192{}, true
+	case n == 0:
+		return x, true
+	case n >= uint(len(pow10tab192)):
+		return Uint192{}, false
+	default:
+		return x.MulCheck(pow10tab192[n])
+	}
+}
+
+// GoString returns x as a call to [U192] that produces x.
 func (x Uint192) GoString() string {
-	return fmt.Sprintf("[%d %d %d]", x.u0, x.u1, x.u2)
+	return fmt.Sprintf("fixed.U192(%#x, %#x, %#x)", x.u0, x.u1, x.u2)
+}
+
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U192].
+func (x Uint192) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint192) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint192) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint192) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
 }
 
 // String returns the base-10 representation of x.
 func (x Uint192) String() string {
-	if x.u2 == 0 {
-		return x.low128().String()
-	}
-	b := make([]byte, 58)
+	var b [58]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint192) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint192) AppendDecimal(dst []byte) []byte {
+	var b [58]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint192.
+func (x Uint192) FormatInto(b *[58]byte) []byte {
 	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint192 returns the value of s in the given base.
@@ -483,3 +1072,64 @@ func ParseUint192(s string, base int) (Uint192, error) {
 	x, _, _, err := parseUint[Uint192](s, base, false)
 	return x, err
 }
+
+var pow10tab192 = [...]Uint192{
+	{1, 0, 0},
+	{10, 0, 0},
+	{100, 0, 0},
+	{1000, 0, 0},
+	{10000, 0, 0},
+	{100000, 0, 0},
+	{1000000, 0, 0},
+	{10000000, 0, 0},
+	{100000000, 0, 0},
+	{1000000000, 0, 0},
+	{10000000000, 0, 0},
+	{100000000000, 0, 0},
+	{1000000000000, 0, 0},
+	{10000000000000, 0, 0},
+	{100000000000000, 0, 0},
+	{1000000000000000, 0, 0},
+	{10000000000000000, 0, 0},
+	{100000000000000000, 0, 0},
+	{1000000000000000000, 0, 0},
+	{10000000000000000000, 0, 0},
+	{7766279631452241920, 5, 0},
+	{3875820019684212736, 54, 0},
+	{1864712049423024128, 542, 0},
+	{200376420520689664, 5421, 0},
+	{2003764205206896640, 54210, 0},
+	{1590897978359414784, 542101, 0},
+	{15908979783594147840, 5421010, 0},
+	{11515845246265065472, 54210108, 0},
+	{4477988020393345024, 542101086, 0},
+	{7886392056514347008, 5421010862, 0},
+	{5076944270305263616, 54210108624, 0},
+	{13875954555633532928, 542101086242, 0},
+	{9632337040368467968, 5421010862427, 0},
+	{4089650035136921600, 54210108624275, 0},
+	{4003012203950112768, 542101086242752, 0},
+	{3136633892082024448, 5421010862427522, 0},
+	{12919594847110692864, 54210108624275221, 0},
+	{68739955140067328, 542101086242752217, 0},
+	{687399551400673280, 5421010862427522170, 0},
+	{6873995514006732800, 17316620476856118468, 2},
+	{13399722918938673152, 7145508105175220139, 29},
+	{4870020673419870208, 16114848830623546549, 293},
+	{11806718586779598848, 13574535716559052564, 2938},
+	{7386721425538678784, 6618148649623664334, 29387},
+	{80237960548581376, 10841254275107988496, 293873},
+	{802379605485813760, 16178822382532126880, 2938735},
+	{8023796054858137600, 14214271235644855872, 29387358},
+	{6450984253743169536, 13015503840481697412, 293873587},
+	{9169610316303040512, 1027829888850112811, 2938735877},
+	{17909126868192198656, 10278298888501128114, 29387358770},
+	{13070572018536022016, 10549268516463523069, 293873587705},
+	{1578511669393358848, 13258964796087472617, 2938735877055},
+	{15785116693933588480, 3462439444907864858, 29387358770557},
+	{10277214349659471872, 16177650375369096972, 293873587705571},
+	{10538423128046960640, 14202551164014556797, 2938735877055718},
+	{13150510911921848320, 12898303124178706663, 29387358770557187},
+	{2377900603251621888, 18302566799529756941, 293873587705571876},
+	{5332261958806667264, 17004971331911604867, 2938735877055718769},
+}
@@ -62,6 +62,23 @@ func TestUint192Cmp(t *testing.T) {
 	}
 }
 
+func TestUint192Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint192()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint192Add(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint192()
@@ -92,7 +109,7 @@ func TestUint192Add64(t *testing.T) {
 		x := randUint192()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -102,9 +119,9 @@ func TestUint192Add64(t *testing.T) {
 		}
 		want.And(want, big192mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -143,7 +160,7 @@ func TestUint192Sub64(t *testing.T) {
 		x := randUint192()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -153,9 +170,9 @@ func TestUint192Sub64(t *testing.T) {
 		}
 		want.And(want, big192mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -195,7 +212,7 @@ func TestUint192Mul64(t *testing.T) {
 		x := randUint192()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -205,11 +222,11 @@ func TestUint192Mul64(t *testing.T) {
 		}
 		want.And(want, big192mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -277,7 +294,7 @@ func TestUint192QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -330,6 +347,142 @@ func TestUint192Rsh(t *testing.T) {
 	}
 }
 
+func TestUint192ExpMod(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		x := randUint192()
+		y := randUint192()
+		m := randUint192()
+		if m.IsZero() {
+			m = U192From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint192Exp(t *testing.T) {
+	mod := new(big.Int).Lsh(big.NewInt(1), 192)
+	for i := 0; i < 1000; i++ {
+		x := randUint192()
+		y := U192From64(uint64(rand.Intn(300)))
+
+		z := x.Exp(y, Uint192{})
+
+		want := new(big.Int).Exp(x.big(), y.big(), mod)
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d: expected %d, got %d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func TestUint192MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint192()
+		y := randUint192()
+		m := randUint192()
+		if m.IsZero() {
+			m = U192From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint192AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint192()
+		y := randUint192()
+		m := randUint192()
+		if m.IsZero() {
+			m = U192From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint192MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 192), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint192()
+		y := randUint192()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 192)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull192(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint192()
+		lo := randUint192()
+		y := randUint192()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull192(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 192)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint192MulDiv(t *testing.T) {
+	testMulDiv(t, randUint192)
+}
+
+func TestUint192Quo(t *testing.T) {
+	testQuo(t, randUint192)
+}
+
+func TestUint192Saturating(t *testing.T) {
+	testSaturating(t, randUint192)
+}
+
+func TestUint192MulPow10(t *testing.T) {
+	testMulPow10(t, randUint192)
+}
+
 func TestUint192String(t *testing.T) {
 	test := func(x Uint192) {
 		want := x.big().String()
@@ -379,6 +532,6 @@ func BenchmarkUint192Mul(b *testing.B) {
 
 func BenchmarkUint192QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.Uint192, sink.uint64 = U192From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.Uint192, sink.uint64 = U192From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
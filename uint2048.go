@@ -3,9 +3,11 @@
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"sync"
 )
@@ -38,6 +40,15 @@ func U2048From64(x uint64) Uint2048 {
 	return Uint2048{u0: x}
 }
 
+// U2048FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint2048].
+func U2048FromFloat64(f float64, mode RoundingMode) (Uint2048, error) {
+	return fromFloat64[Uint2048](f, mode)
+}
+
 func u2048(lo, hi Uint1024) Uint2048 {
 	return Uint2048{
 		lo.u0,
@@ -156,6 +167,21 @@ func (x Uint2048) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+func (x Uint2048) uint64() uint64 {
+	return x.u0
+}
+
+// limbs returns x as little-endian 64-bit words.
+func (x Uint2048) limbs() [32]uint64 {
+	return [32]uint64{x.u0, x.u1, x.u2, x.u3, x.u4, x.u5, x.u6, x.u7, x.u8, x.u9, x.u10, x.u11, x.u12, x.u13, x.u14, x.u15, x.u16, x.u17, x.u18, x.u19, x.u20, x.u21, x.u22, x.u23, x.u24, x.u25, x.u26, x.u27, x.u28, x.u29, x.u30, x.u31}
+}
+
+// u2048FromLimbs constructs a [Uint2048] from little-endian
+// 64-bit words.
+func u2048FromLimbs(v [32]uint64) Uint2048 {
+	return Uint2048{v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7], v[8], v[9], v[10], v[11], v[12], v[13], v[14], v[15], v[16], v[17], v[18], v[19], v[20], v[21], v[22], v[23], v[24], v[25], v[26], v[27], v[28], v[29], v[30], v[31]}
+}
+
 // Bytes encodes x as a little-endian integer.
 func (x Uint2048) Bytes(b *[256]byte) {
 	binary.LittleEndian.PutUint64(b[0:], x.u0)
@@ -233,6 +259,321 @@ func (x *Uint2048) SetBytes(b []byte) error {
 	return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint2048) BigEndianBytes(b *[256]byte) {
+	binary.BigEndian.PutUint64(b[0:], x.u31)
+	binary.BigEndian.PutUint64(b[8:], x.u30)
+	binary.BigEndian.PutUint64(b[16:], x.u29)
+	binary.BigEndian.PutUint64(b[24:], x.u28)
+	binary.BigEndian.PutUint64(b[32:], x.u27)
+	binary.BigEndian.PutUint64(b[40:], x.u26)
+	binary.BigEndian.PutUint64(b[48:], x.u25)
+	binary.BigEndian.PutUint64(b[56:], x.u24)
+	binary.BigEndian.PutUint64(b[64:], x.u23)
+	binary.BigEndian.PutUint64(b[72:], x.u22)
+	binary.BigEndian.PutUint64(b[80:], x.u21)
+	binary.BigEndian.PutUint64(b[88:], x.u20)
+	binary.BigEndian.PutUint64(b[96:], x.u19)
+	binary.BigEndian.PutUint64(b[104:], x.u18)
+	binary.BigEndian.PutUint64(b[112:], x.u17)
+	binary.BigEndian.PutUint64(b[120:], x.u16)
+	binary.BigEndian.PutUint64(b[128:], x.u15)
+	binary.BigEndian.PutUint64(b[136:], x.u14)
+	binary.BigEndian.PutUint64(b[144:], x.u13)
+	binary.BigEndian.PutUint64(b[152:], x.u12)
+	binary.BigEndian.PutUint64(b[160:], x.u11)
+	binary.BigEndian.PutUint64(b[168:], x.u10)
+	binary.BigEndian.PutUint64(b[176:], x.u9)
+	binary.BigEndian.PutUint64(b[184:], x.u8)
+	binary.BigEndian.PutUint64(b[192:], x.u7)
+	binary.BigEndian.PutUint64(b[200:], x.u6)
+	binary.BigEndian.PutUint64(b[208:], x.u5)
+	binary.BigEndian.PutUint64(b[216:], x.u4)
+	binary.BigEndian.PutUint64(b[224:], x.u3)
+	binary.BigEndian.PutUint64(b[232:], x.u2)
+	binary.BigEndian.PutUint64(b[240:], x.u1)
+	binary.BigEndian.PutUint64(b[248:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint2048) SetBigEndianBytes(b []byte) error {
+	if len(b) != 256 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u31 = binary.BigEndian.Uint64(b[0:])
+	x.u30 = binary.BigEndian.Uint64(b[8:])
+	x.u29 = binary.BigEndian.Uint64(b[16:])
+	x.u28 = binary.BigEndian.Uint64(b[24:])
+	x.u27 = binary.BigEndian.Uint64(b[32:])
+	x.u26 = binary.BigEndian.Uint64(b[40:])
+	x.u25 = binary.BigEndian.Uint64(b[48:])
+	x.u24 = binary.BigEndian.Uint64(b[56:])
+	x.u23 = binary.BigEndian.Uint64(b[64:])
+	x.u22 = binary.BigEndian.Uint64(b[72:])
+	x.u21 = binary.BigEndian.Uint64(b[80:])
+	x.u20 = binary.BigEndian.Uint64(b[88:])
+	x.u19 = binary.BigEndian.Uint64(b[96:])
+	x.u18 = binary.BigEndian.Uint64(b[104:])
+	x.u17 = binary.BigEndian.Uint64(b[112:])
+	x.u16 = binary.BigEndian.Uint64(b[120:])
+	x.u15 = binary.BigEndian.Uint64(b[128:])
+	x.u14 = binary.BigEndian.Uint64(b[136:])
+	x.u13 = binary.BigEndian.Uint64(b[144:])
+	x.u12 = binary.BigEndian.Uint64(b[152:])
+	x.u11 = binary.BigEndian.Uint64(b[160:])
+	x.u10 = binary.BigEndian.Uint64(b[168:])
+	x.u9 = binary.BigEndian.Uint64(b[176:])
+	x.u8 = binary.BigEndian.Uint64(b[184:])
+	x.u7 = binary.BigEndian.Uint64(b[192:])
+	x.u6 = binary.BigEndian.Uint64(b[200:])
+	x.u5 = binary.BigEndian.Uint64(b[208:])
+	x.u4 = binary.BigEndian.Uint64(b[216:])
+	x.u3 = binary.BigEndian.Uint64(b[224:])
+	x.u2 = binary.BigEndian.Uint64(b[232:])
+	x.u1 = binary.BigEndian.Uint64(b[240:])
+	x.u0 = binary.BigEndian.Uint64(b[248:])
+	return nil
+}
+
+// AppendBytes appends the 256-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint2048) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint64(dst, x.u1)
+		dst = order.AppendUint64(dst, x.u2)
+		dst = order.AppendUint64(dst, x.u3)
+		dst = order.AppendUint64(dst, x.u4)
+		dst = order.AppendUint64(dst, x.u5)
+		dst = order.AppendUint64(dst, x.u6)
+		dst = order.AppendUint64(dst, x.u7)
+		dst = order.AppendUint64(dst, x.u8)
+		dst = order.AppendUint64(dst, x.u9)
+		dst = order.AppendUint64(dst, x.u10)
+		dst = order.AppendUint64(dst, x.u11)
+		dst = order.AppendUint64(dst, x.u12)
+		dst = order.AppendUint64(dst, x.u13)
+		dst = order.AppendUint64(dst, x.u14)
+		dst = order.AppendUint64(dst, x.u15)
+		dst = order.AppendUint64(dst, x.u16)
+		dst = order.AppendUint64(dst, x.u17)
+		dst = order.AppendUint64(dst, x.u18)
+		dst = order.AppendUint64(dst, x.u19)
+		dst = order.AppendUint64(dst, x.u20)
+		dst = order.AppendUint64(dst, x.u21)
+		dst = order.AppendUint64(dst, x.u22)
+		dst = order.AppendUint64(dst, x.u23)
+		dst = order.AppendUint64(dst, x.u24)
+		dst = order.AppendUint64(dst, x.u25)
+		dst = order.AppendUint64(dst, x.u26)
+		dst = order.AppendUint64(dst, x.u27)
+		dst = order.AppendUint64(dst, x.u28)
+		dst = order.AppendUint64(dst, x.u29)
+		dst = order.AppendUint64(dst, x.u30)
+		dst = order.AppendUint64(dst, x.u31)
+		return dst
+	}
+	dst = order.AppendUint64(dst, x.u31)
+	dst = order.AppendUint64(dst, x.u30)
+	dst = order.AppendUint64(dst, x.u29)
+	dst = order.AppendUint64(dst, x.u28)
+	dst = order.AppendUint64(dst, x.u27)
+	dst = order.AppendUint64(dst, x.u26)
+	dst = order.AppendUint64(dst, x.u25)
+	dst = order.AppendUint64(dst, x.u24)
+	dst = order.AppendUint64(dst, x.u23)
+	dst = order.AppendUint64(dst, x.u22)
+	dst = order.AppendUint64(dst, x.u21)
+	dst = order.AppendUint64(dst, x.u20)
+	dst = order.AppendUint64(dst, x.u19)
+	dst = order.AppendUint64(dst, x.u18)
+	dst = order.AppendUint64(dst, x.u17)
+	dst = order.AppendUint64(dst, x.u16)
+	dst = order.AppendUint64(dst, x.u15)
+	dst = order.AppendUint64(dst, x.u14)
+	dst = order.AppendUint64(dst, x.u13)
+	dst = order.AppendUint64(dst, x.u12)
+	dst = order.AppendUint64(dst, x.u11)
+	dst = order.AppendUint64(dst, x.u10)
+	dst = order.AppendUint64(dst, x.u9)
+	dst = order.AppendUint64(dst, x.u8)
+	dst = order.AppendUint64(dst, x.u7)
+	dst = order.AppendUint64(dst, x.u6)
+	dst = order.AppendUint64(dst, x.u5)
+	dst = order.AppendUint64(dst, x.u4)
+	dst = order.AppendUint64(dst, x.u3)
+	dst = order.AppendUint64(dst, x.u2)
+	dst = order.AppendUint64(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint2048) FillBytes(buf []byte) []byte {
+	var b [256]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 256 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 256 bytes.
+func (x *Uint2048) SetBytesPadded(b []byte) error {
+	if len(b) > 256 {
+		return ErrOverflow
+	}
+	var be [256]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 256 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint2048) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 256)
+	_ = x.SetBytesPadded(b) // len(b) <= 256
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint2048) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint2048) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint2048.Bytes].
+func (x Uint2048) MarshalBinary() ([]byte, error) {
+	var b [256]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint2048.SetBytes].
+func (x *Uint2048) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint2048) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint2048) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint2048) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint2048) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint2048].
+func (x *Uint2048) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint2048) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint2048) FillBig(z *big.Int) *big.Int {
+	v := x.limbs()
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^2048 and reports whether v is
+// negative or does not fit in 2048 bits.
+func (x *Uint2048) SetBig(v *big.Int) (overflow bool) {
+	var z [32]uint64
+	overflow = setBig(z[:], v, 2048)
+	*x = u2048FromLimbs(z)
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint2048) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^2048, and reports whether the rounded value is
+// negative or does not fit in 2048 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint2048) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint2048{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint2048) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint2048) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint2048) Size() int {
 	return 2048
@@ -392,12 +733,12 @@ func (x Uint2048) Cmp(y Uint2048) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint2048) cmp64(y uint64) int {
+func (x Uint2048) Cmp64(y uint64) int {
 	v := x
 	v.u0 = 0
 	if !v.IsZero() {
@@ -1957,8 +2298,8 @@ func (x Uint2048) Add(y Uint2048) Uint2048 {
 	return z
 }
 
-// add64 returns x+y.
-func (x Uint2048) add64(y uint64) Uint2048 {
+// Add64 returns x+y.
+func (x Uint2048) Add64(y uint64) Uint2048 {
 	var z Uint2048
 	var carry uint64
 	z.u0, carry = bits.Add64(x.u0, y, 0)
@@ -2035,10 +2376,10 @@ func (x Uint2048) AddCheck(y Uint2048) (z Uint2048, carry uint64) {
 	return z, carry
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint2048) addCheck64(y uint64) (z Uint2048, carry uint64) {
+func (x Uint2048) AddCheck64(y uint64) (z Uint2048, carry uint64) {
 	z.u0, carry = bits.Add64(x.u0, y, 0)
 	z.u1, carry = bits.Add64(x.u1, 0, carry)
 	z.u2, carry = bits.Add64(x.u2, 0, carry)
@@ -2113,8 +2454,8 @@ func (x Uint2048) Sub(y Uint2048) Uint2048 {
 	return z
 }
 
-// sub64 returns x-y.
-func (x Uint2048) sub64(y uint64) Uint2048 {
+// Sub64 returns x-y.
+func (x Uint2048) Sub64(y uint64) Uint2048 {
 	var z Uint2048
 	var borrow uint64
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
@@ -2191,10 +2532,10 @@ func (x Uint2048) SubCheck(y Uint2048) (z Uint2048, borrow uint64) {
 	return z, borrow
 }
 
-// subCheck64 returns x-y.
+// SubCheck64 returns x-y.
 //
 // borrow is 1 if x-y overflows and 0 otherwise.
-func (x Uint2048) subCheck64(y uint64) (z Uint2048, borrow uint64) {
+func (x Uint2048) SubCheck64(y uint64) (z Uint2048, borrow uint64) {
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
 	z.u1, borrow = bits.Sub64(x.u1, 0, borrow)
 	z.u2, borrow = bits.Sub64(x.u2, 0, borrow)
@@ -3357,7 +3698,8 @@ func (x Uint2048) mul1024(y Uint1024) Uint2048 {
 	return z
 }
 
-func (x Uint2048) mul64(y uint64) Uint2048 {
+// Mul64 returns x*y.
+func (x Uint2048) Mul64(y uint64) Uint2048 {
 	if y == 0 {
 		return Uint2048{}
 	}
@@ -3398,63 +3740,156 @@ func (x Uint2048) mul64(y uint64) Uint2048 {
 	return z
 }
 
-// Exp return x^y mod m.
+// MulFull returns the full 2*2048-bit product x*y as
+// (hi, lo).
 //
-// If m == 0, Exp simply returns x^y.
-func (x Uint2048) Exp(y, m Uint2048) Uint2048 {
-	const mask = 1 << (64 - 1)
+// It is the 2048-bit analogue of [bits.Mul64].
+func (x Uint2048) MulFull(y Uint2048) (hi, lo Uint2048) {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [2 * 32]uint64
+	for i, d := range yv {
+		if d == 0 {
+			continue
+		}
+		var c uint64
+		for j, v := range xv {
+			c, z[i+j] = mulAddWWWW(v, d, z[i+j], c)
+		}
+		z[i+len(xv)] = c
+	}
+	hi = u2048FromLimbs(*(*[32]uint64)(z[32:]))
+	lo = u2048FromLimbs(*(*[32]uint64)(z[:32]))
+	return hi, lo
+}
 
-	// x^0 = 1
-	if y.IsZero() {
-		return U2048From64(1)
+// mod returns x mod m.
+func (x Uint2048) mod(m Uint2048) Uint2048 {
+	if x.Cmp(m) < 0 {
+		return x
 	}
+	_, r := x.QuoRem(m)
+	return r
+}
 
-	// x^1 mod m == x mod m
-	mod := !m.IsZero()
-	if y == U2048From64(1) && mod {
-		_, r := x.QuoRem(m)
-		return r
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint2048) AddMod(y, m Uint2048) Uint2048 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
 	}
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint2048) MulMod(y, m Uint2048) Uint2048 {
+	hi, lo := x.mod(m).MulFull(y.mod(m))
+	_, r := div2048(hi, lo, m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint2048) SqrMod(m Uint2048) Uint2048 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint2048) MulDiv(y, z Uint2048) (Uint2048, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint2048) MulDivRoundUp(y, z Uint2048) (Uint2048, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
 
-	yv := []uint64{
-		y.u0, y.u1, y.u2, y.u3, y.u4, y.u5, y.u6, y.u7, y.u8, y.u9, y.u10, y.u11, y.u12, y.u13, y.u14, y.u15, y.u16, y.u17, y.u18, y.u19, y.u20, y.u21, y.u22, y.u23, y.u24, y.u25, y.u26, y.u27, y.u28, y.u29, y.u30,
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint2048) MulDivRound(y, z Uint2048, mode RoundingMode) (Uint2048, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint2048{}, false
 	}
-	i := len(yv)
-	for i > 0 && yv[i-1] == 0 {
-		i--
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint2048.
+func (x Uint2048) mulDiv(y, z Uint2048) (q, r Uint2048, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
 	}
-	yv = yv[:i]
+	hi, lo := x.MulFull(y)
+	if z.Cmp(hi) <= 0 {
+		return Uint2048{}, Uint2048{}, false
+	}
+	q, r = div2048(hi, lo, z)
+	return q, r, true
+}
 
-	// TODO(eric): if x > 1 and y > 0 && mod, then use montgomery
-	// or windowed exponentiation.
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint2048) Exp(y, m Uint2048) Uint2048 {
+	switch {
+	case m.IsZero():
+		return x.exp(y)
+	case m == U2048From64(1):
+		return Uint2048{}
+	case m.u0&1 != 0:
+		// NewMont2048 only fails for even moduli.
+		c, _ := NewMont2048(m)
+		return c.Exp(x, y)
+	}
 
-	z := x
-	v := yv[len(yv)-1]
-	s := bits.LeadingZeros64(v) + 1
-	v <<= s
-	w := 64 - s
-	for j := 0; j < w; j++ {
-		z = z.Mul(z)
-		if v&mask != 0 {
-			z = z.Mul(x)
+	z := U2048From64(1)
+	x = x.mod(m)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.SqrMod(m)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.MulMod(x, m)
 		}
-		if mod {
-			_, z = z.QuoRem(m)
-		}
-		v <<= 1
 	}
+	return z
+}
 
-	for i := len(yv) - 2; i >= 0; i-- {
-		v := yv[i]
-		for j := 0; j < 64; j++ {
-			z = z.Mul(z)
-			if v&mask != 0 {
-				z = z.Mul(x)
-			}
-			if mod {
-				_, z = z.QuoRem(m)
-			}
-			v <<= 1
+// exp returns x^y.
+func (x Uint2048) exp(y Uint2048) Uint2048 {
+	z := U2048From64(1)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.Mul(z)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.Mul(x)
 		}
 	}
 	return z
@@ -3467,8 +3902,10 @@ func (x Uint2048) mulPow10(n uint) (Uint2048, bool) {
 		return Uint2048{}, true
 	case n == 0:
 		return x, true
+	case n >= 617:
+		return Uint2048{}, false
 	default:
-		return x.MulCheck(U2048From64(10).Exp(U2048From64(uint64(n)), U2048From64(0)))
+		return x.MulCheck(U2048From64(10).exp(U2048From64(uint64(n))))
 	}
 }
 
@@ -3479,11 +3916,10 @@ var pow10tabUint2048 struct {
 
 func pow10Uint2048(n uint) Uint2048 {
 	pow10tabUint2048.once.Do(func() {
-		tab := make([]Uint2048, 2+617)
-		tab[0] = Uint2048{}
-		tab[1] = U2048From64(1)
-		for i := 2; i < len(tab); i++ {
-			tab[i] = tab[i-1].mul64(10)
+		tab := make([]Uint2048, 617)
+		tab[0] = U2048From64(1)
+		for i := 1; i < len(tab); i++ {
+			tab[i] = tab[i-1].Mul64(10)
 		}
 		pow10tabUint2048.values = tab
 	})
@@ -3493,7 +3929,10 @@ func pow10Uint2048(n uint) Uint2048 {
 // MulCheck returns x*y and reports whether the multiplication
 // oveflowed.
 func (x Uint2048) MulCheck(y Uint2048) (Uint2048, bool) {
-	if x.BitLen()+y.BitLen() > 2048 {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > 2048+1 {
 		return Uint2048{}, false
 	}
 
@@ -4255,7 +4694,9 @@ func (x Uint2048) MulCheck(y Uint2048) (Uint2048, bool) {
 	return z, true
 }
 
-func (x Uint2048) mulCheck64(y uint64) (Uint2048, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint2048) MulCheck64(y uint64) (Uint2048, bool) {
 	if y == 0 {
 		return Uint2048{}, true
 	}
@@ -4299,6 +4740,89 @@ func (x Uint2048) mulCheck64(y uint64) (Uint2048, bool) {
 	return z, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint2048 if x+y
+// overflows.
+func (x Uint2048) SaturatingAdd(y Uint2048) Uint2048 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint2048) SaturatingSub(y Uint2048) Uint2048 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint2048{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint2048 if x*y
+// overflows.
+func (x Uint2048) SaturatingMul(y Uint2048) Uint2048 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint2048 if any set
+// bits are shifted out.
+func (x Uint2048) SaturatingLsh(n uint) Uint2048 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint2048) Quo(y Uint2048) Uint2048 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint2048) Rem(y Uint2048) Uint2048 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint2048) QuoCeil(y Uint2048) Uint2048 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint2048) QuoRound(y Uint2048, mode RoundingMode) Uint2048 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint2048.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint2048) QuoRemCheck(y Uint2048) (q, r Uint2048, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -4321,13 +4845,13 @@ func (x Uint2048) QuoRem(y Uint2048) (q, r Uint2048) {
 	tq, _ := div1024(x1.high(), x1.low(), y1.high())
 	tq = tq.Rsh(1023 - n) // tq >>= 1023 - n
 	if !tq.IsZero() {
-		tq = tq.sub64(1) // tq--
+		tq = tq.Sub64(1) // tq--
 	}
 	q = u2048(tq, Uint1024{})
 	ytq := y.mul1024(tq) // ytq := y*tq
 	r = x.Sub(ytq)       // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
@@ -4347,11 +4871,11 @@ func (x Uint2048) quoRem1024(y Uint1024) (q Uint2048, r Uint1024) {
 	return u2048(lo, hi), r
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint2048) quoRem64(y uint64) (q Uint2048, r uint64) {
+func (x Uint2048) QuoRem64(y uint64) (q Uint2048, r uint64) {
 	q.u31, r = bits.Div64(0, x.u31, y)
 	q.u30, r = bits.Div64(r, x.u30, y)
 	q.u29, r = bits.Div64(r, x.u29, y)
@@ -4414,7 +4938,7 @@ func div2048(hi, lo, y Uint2048) (q, r Uint2048) {
 
 	// for q1 >= two1024 || q1*yn0 > two1024*rhat+un1 { ... }
 	for !q1.high().IsZero() || q1.mul1024(yn0).Cmp(u2048(un1, rhat)) > 0 {
-		q1 = q1.sub64(1)             // q1--
+		q1 = q1.Sub64(1)             // q1--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -4427,7 +4951,7 @@ func div2048(hi, lo, y Uint2048) (q, r Uint2048) {
 
 	// for q0 >= two1024 || q0*yn0 > two1024*rhat+un0 { ... }
 	for !q0.high().IsZero() || q0.mul1024(yn0).Cmp(u2048(un0, rhat)) > 0 {
-		q0 = q0.sub64(1)             // q0--
+		q0 = q0.Sub64(1)             // q0--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -4441,8 +4965,24 @@ func div2048(hi, lo, y Uint2048) (q, r Uint2048) {
 	return
 }
 
+// DivFull2048 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull2048 panics for y == 0 (division by zero) or
+// y <= hi (quotient overflow).
+//
+// It is the 2048-bit analogue of [bits.Div64].
+func DivFull2048(hi, lo, y Uint2048) (q, r Uint2048) {
+	return div2048(hi, lo, y)
+}
+
+// GoString returns x as a call to [U2048] that produces x.
 func (x Uint2048) GoString() string {
-	return fmt.Sprintf("[%d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d %d]",
+	return fmt.Sprintf("fixed.U2048(%#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x)",
 		x.u0,
 		x.u1,
 		x.u2,
@@ -4478,19 +5018,98 @@ func (x Uint2048) GoString() string {
 	)
 }
 
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U2048].
+func (x Uint2048) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint2048) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint2048) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint2048) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
+}
+
 // String returns the base-10 representation of x.
 func (x Uint2048) String() string {
-	b := make([]byte, 617)
+	var b [617]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint2048) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint2048) AppendDecimal(dst []byte) []byte {
+	var b [617]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint2048.
+func (x Uint2048) FormatInto(b *[617]byte) []byte {
 	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint2048 returns the value of s in the given base.
@@ -4498,3 +5117,118 @@ func ParseUint2048(s string, base int) (Uint2048, error) {
 	x, _, _, err := parseUint[Uint2048](s, base, false)
 	return x, err
 }
+
+// Mont2048 is a Montgomery arithmetic context for an odd
+// 2048-bit modulus.
+//
+// Values in Montgomery form are represented as x*R mod m, where
+// R = 2^2048.
+//
+// Mont2048 is not constant time and should not be used with
+// secret exponents.
+type Mont2048 struct {
+	m   [32]uint64
+	r2  Uint2048 // R^2 mod m
+	one Uint2048 // R mod m
+	k   uint64   // -m^-1 mod 2^64
+}
+
+var _ Mont[Uint2048] = (*Mont2048)(nil)
+
+// NewMont2048 creates a Montgomery context for the odd modulus
+// m.
+func NewMont2048(m Uint2048) (*Mont2048, error) {
+	if m.u0&1 == 0 {
+		return nil, errEvenModulus
+	}
+	_, one := Uint2048{}.Sub(m).QuoRem(m) // 2^2048 mod m
+	r2 := one
+	for i := 0; i < 2048; i++ {
+		// r2 = 2*r2 mod m
+		var c uint64
+		r2, c = r2.AddCheck(r2)
+		if c != 0 || r2.Cmp(m) >= 0 {
+			r2 = r2.Sub(m)
+		}
+	}
+	return &Mont2048{
+		m:   m.limbs(),
+		r2:  r2,
+		one: one,
+		k:   montK(m.u0),
+	}, nil
+}
+
+// Modulus returns the modulus m.
+func (c *Mont2048) Modulus() Uint2048 {
+	return u2048FromLimbs(c.m)
+}
+
+// ToMont converts x to Montgomery form.
+func (c *Mont2048) ToMont(x Uint2048) Uint2048 {
+	if m := c.Modulus(); x.Cmp(m) >= 0 {
+		_, x = x.QuoRem(m)
+	}
+	return c.MulMont(x, c.r2)
+}
+
+// FromMont converts x from Montgomery form.
+func (c *Mont2048) FromMont(x Uint2048) Uint2048 {
+	return c.MulMont(x, U2048From64(1))
+}
+
+// MulMont returns x*y*R^-1 mod m.
+//
+// Both x and y must be less than m. If x and y are in
+// Montgomery form, then so is the result.
+func (c *Mont2048) MulMont(x, y Uint2048) Uint2048 {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [32]uint64
+	var t [32 + 2]uint64
+	montMul(z[:], xv[:], yv[:], c.m[:], t[:], c.k)
+	return u2048FromLimbs(z)
+}
+
+// ExpMont returns x^y mod m, where x and the result are in
+// Montgomery form.
+//
+// x must be less than m.
+func (c *Mont2048) ExpMont(x, y Uint2048) Uint2048 {
+	// Fixed 4-bit window.
+	var tab [16]Uint2048
+	tab[0] = c.one
+	tab[1] = x
+	for i := 2; i < len(tab); i++ {
+		tab[i] = c.MulMont(tab[i-1], x)
+	}
+
+	z := c.one
+	started := false
+	yv := y.limbs()
+	for i := len(yv) - 1; i >= 0; i-- {
+		for s := 60; s >= 0; s -= 4 {
+			w := (yv[i] >> s) & 15
+			if !started {
+				if w != 0 {
+					z = tab[w]
+					started = true
+				}
+				continue
+			}
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			if w != 0 {
+				z = c.MulMont(z, tab[w])
+			}
+		}
+	}
+	return z
+}
+
+// Exp returns x^y mod m.
+func (c *Mont2048) Exp(x, y Uint2048) Uint2048 {
+	return c.FromMont(c.ExpMont(c.ToMont(x), y))
+}
@@ -276,6 +276,23 @@ func TestUint2048Cmp(t *testing.T) {
 	}
 }
 
+func TestUint2048Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint2048()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint2048And(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint2048()
@@ -391,7 +408,7 @@ func TestUint2048Add64(t *testing.T) {
 		x := randUint2048()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -401,9 +418,9 @@ func TestUint2048Add64(t *testing.T) {
 		}
 		want.And(want, big2048mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -442,7 +459,7 @@ func TestUint2048Sub64(t *testing.T) {
 		x := randUint2048()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -452,9 +469,9 @@ func TestUint2048Sub64(t *testing.T) {
 		}
 		want.And(want, big2048mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -494,7 +511,7 @@ func TestUint2048Mul64(t *testing.T) {
 		x := randUint2048()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -504,11 +521,11 @@ func TestUint2048Mul64(t *testing.T) {
 		}
 		want.And(want, big2048mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -520,7 +537,7 @@ func TestUint2048Exp(t *testing.T) {
 	x := U2048From64(1)
 	ten := U2048From64(10)
 	for i := 1; ; i++ {
-		want, ok := x.mulCheck64(10)
+		want, ok := x.MulCheck64(10)
 		if !ok {
 			break
 		}
@@ -532,6 +549,126 @@ func TestUint2048Exp(t *testing.T) {
 	}
 }
 
+func TestUint2048ExpMod(t *testing.T) {
+	for i := 0; i < 32; i++ {
+		x := randUint2048()
+		y := randUint2048()
+		m := randUint2048()
+		if m.IsZero() {
+			m = U2048From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint2048MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint2048()
+		y := randUint2048()
+		m := randUint2048()
+		if m.IsZero() {
+			m = U2048From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint2048AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint2048()
+		y := randUint2048()
+		m := randUint2048()
+		if m.IsZero() {
+			m = U2048From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint2048MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 2048), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint2048()
+		y := randUint2048()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 2048)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull2048(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint2048()
+		lo := randUint2048()
+		y := randUint2048()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull2048(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 2048)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint2048MulDiv(t *testing.T) {
+	testMulDiv(t, randUint2048)
+}
+
+func TestUint2048Quo(t *testing.T) {
+	testQuo(t, randUint2048)
+}
+
+func TestUint2048Saturating(t *testing.T) {
+	testSaturating(t, randUint2048)
+}
+
+func TestUint2048MulPow10(t *testing.T) {
+	testMulPow10(t, randUint2048)
+}
+
 func TestUint2048QuoRem(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint2048()
@@ -592,7 +729,7 @@ func TestUint2048QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -644,6 +781,70 @@ func TestParseUint2048(t *testing.T) {
 	}
 }
 
+func randMont2048() *Mont2048 {
+	m := randUint2048()
+	m.u0 |= 1
+	c, err := NewMont2048(m)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func TestMont2048Mul(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		c := randMont2048()
+		m := c.Modulus()
+		x := randUint2048()
+		y := randUint2048()
+
+		z := c.FromMont(c.MulMont(c.ToMont(x), c.ToMont(y)))
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestMont2048Exp(t *testing.T) {
+	for i := 0; i < 32; i++ {
+		c := randMont2048()
+		m := c.Modulus()
+		x := randUint2048()
+		y := randUint2048()
+
+		z := c.Exp(x, y)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestNewMont2048Even(t *testing.T) {
+	for _, m := range []Uint2048{{}, U2048From64(2), Uint2048{}.max().Sub(U2048From64(1))} {
+		if _, err := NewMont2048(m); err == nil {
+			t.Fatalf("%d: expected an error", m.big())
+		}
+	}
+}
+
+func BenchmarkMont2048Exp(b *testing.B) {
+	c := randMont2048()
+	x := c.ToMont(randUint2048())
+	y := Uint2048{}.max()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink.Uint2048 = c.ExpMont(x, y)
+	}
+}
+
 func BenchmarkUint2048Add(b *testing.B) {
 	s := make([]Uint2048, 1000)
 	for i := range s {
@@ -694,6 +895,6 @@ func BenchmarkUint2048QuoRem(b *testing.B) {
 
 func BenchmarkUint2048QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.Uint2048, sink.uint64 = U2048From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.Uint2048, sink.uint64 = U2048From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
@@ -3,9 +3,11 @@
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"sync"
 )
@@ -38,6 +40,15 @@ func U256From64(x uint64) Uint256 {
 	return Uint256{u0: x}
 }
 
+// U256FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint256].
+func U256FromFloat64(f float64, mode RoundingMode) (Uint256, error) {
+	return fromFloat64[Uint256](f, mode)
+}
+
 func u256(lo, hi Uint128) Uint256 {
 	return Uint256{lo.u0, lo.u1, hi.u0, hi.u1}
 }
@@ -63,6 +74,21 @@ func (x Uint256) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+func (x Uint256) uint64() uint64 {
+	return x.u0
+}
+
+// limbs returns x as little-endian 64-bit words.
+func (x Uint256) limbs() [4]uint64 {
+	return [4]uint64{x.u0, x.u1, x.u2, x.u3}
+}
+
+// u256FromLimbs constructs a [Uint256] from little-endian
+// 64-bit words.
+func u256FromLimbs(v [4]uint64) Uint256 {
+	return Uint256{v[0], v[1], v[2], v[3]}
+}
+
 // Bytes encodes x as a little-endian integer.
 func (x Uint256) Bytes(b *[32]byte) {
 	binary.LittleEndian.PutUint64(b[0:], x.u0)
@@ -84,6 +110,209 @@ func (x *Uint256) SetBytes(b []byte) error {
 	return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint256) BigEndianBytes(b *[32]byte) {
+	binary.BigEndian.PutUint64(b[0:], x.u3)
+	binary.BigEndian.PutUint64(b[8:], x.u2)
+	binary.BigEndian.PutUint64(b[16:], x.u1)
+	binary.BigEndian.PutUint64(b[24:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint256) SetBigEndianBytes(b []byte) error {
+	if len(b) != 32 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u3 = binary.BigEndian.Uint64(b[0:])
+	x.u2 = binary.BigEndian.Uint64(b[8:])
+	x.u1 = binary.BigEndian.Uint64(b[16:])
+	x.u0 = binary.BigEndian.Uint64(b[24:])
+	return nil
+}
+
+// AppendBytes appends the 32-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint256) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint64(dst, x.u1)
+		dst = order.AppendUint64(dst, x.u2)
+		dst = order.AppendUint64(dst, x.u3)
+		return dst
+	}
+	dst = order.AppendUint64(dst, x.u3)
+	dst = order.AppendUint64(dst, x.u2)
+	dst = order.AppendUint64(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint256) FillBytes(buf []byte) []byte {
+	var b [32]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 32 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 32 bytes.
+func (x *Uint256) SetBytesPadded(b []byte) error {
+	if len(b) > 32 {
+		return ErrOverflow
+	}
+	var be [32]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 32 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint256) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 32)
+	_ = x.SetBytesPadded(b) // len(b) <= 32
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint256) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint256) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint256.Bytes].
+func (x Uint256) MarshalBinary() ([]byte, error) {
+	var b [32]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint256.SetBytes].
+func (x *Uint256) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint256) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint256) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint256) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint256) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint256].
+func (x *Uint256) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint256) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint256) FillBig(z *big.Int) *big.Int {
+	v := x.limbs()
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^256 and reports whether v is
+// negative or does not fit in 256 bits.
+func (x *Uint256) SetBig(v *big.Int) (overflow bool) {
+	var z [4]uint64
+	overflow = setBig(z[:], v, 256)
+	*x = u256FromLimbs(z)
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint256) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^256, and reports whether the rounded value is
+// negative or does not fit in 256 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint256) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint256{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint256) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint256) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint256) Size() int {
 	return 256
@@ -131,12 +360,12 @@ func (x Uint256) Cmp(y Uint256) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint256) cmp64(y uint64) int {
+func (x Uint256) Cmp64(y uint64) int {
 	v := x
 	v.u0 = 0
 	if !v.IsZero() {
@@ -268,8 +497,8 @@ func (x Uint256) Add(y Uint256) Uint256 {
 	return z
 }
 
-// add64 returns x+y.
-func (x Uint256) add64(y uint64) Uint256 {
+// Add64 returns x+y.
+func (x Uint256) Add64(y uint64) Uint256 {
 	var z Uint256
 	var carry uint64
 	z.u0, carry = bits.Add64(x.u0, y, 0)
@@ -290,10 +519,10 @@ func (x Uint256) AddCheck(y Uint256) (z Uint256, carry uint64) {
 	return z, carry
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint256) addCheck64(y uint64) (z Uint256, carry uint64) {
+func (x Uint256) AddCheck64(y uint64) (z Uint256, carry uint64) {
 	z.u0, carry = bits.Add64(x.u0, y, 0)
 	z.u1, carry = bits.Add64(x.u1, 0, carry)
 	z.u2, carry = bits.Add64(x.u2, 0, carry)
@@ -312,8 +541,8 @@ func (x Uint256) Sub(y Uint256) Uint256 {
 	return z
 }
 
-// sub64 returns x-y.
-func (x Uint256) sub64(y uint64) Uint256 {
+// Sub64 returns x-y.
+func (x Uint256) Sub64(y uint64) Uint256 {
 	var z Uint256
 	var borrow uint64
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
@@ -334,10 +563,10 @@ func (x Uint256) SubCheck(y Uint256) (z Uint256, borrow uint64) {
 	return z, borrow
 }
 
-// subCheck64 returns x-y.
+// SubCheck64 returns x-y.
 //
 // borrow is 1 if x-y overflows and 0 otherwise.
-func (x Uint256) subCheck64(y uint64) (z Uint256, borrow uint64) {
+func (x Uint256) SubCheck64(y uint64) (z Uint256, borrow uint64) {
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
 	z.u1, borrow = bits.Sub64(x.u1, 0, borrow)
 	z.u2, borrow = bits.Sub64(x.u2, 0, borrow)
@@ -401,7 +630,8 @@ func (x Uint256) mul128(y Uint128) Uint256 {
 	return z
 }
 
-func (x Uint256) mul64(y uint64) Uint256 {
+// Mul64 returns x*y.
+func (x Uint256) Mul64(y uint64) Uint256 {
 	if y == 0 {
 		return Uint256{}
 	}
@@ -414,63 +644,156 @@ func (x Uint256) mul64(y uint64) Uint256 {
 	return z
 }
 
-// Exp return x^y mod m.
+// MulFull returns the full 2*256-bit product x*y as
+// (hi, lo).
 //
-// If m == 0, Exp simply returns x^y.
-func (x Uint256) Exp(y, m Uint256) Uint256 {
-	const mask = 1 << (64 - 1)
+// It is the 256-bit analogue of [bits.Mul64].
+func (x Uint256) MulFull(y Uint256) (hi, lo Uint256) {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [2 * 4]uint64
+	for i, d := range yv {
+		if d == 0 {
+			continue
+		}
+		var c uint64
+		for j, v := range xv {
+			c, z[i+j] = mulAddWWWW(v, d, z[i+j], c)
+		}
+		z[i+len(xv)] = c
+	}
+	hi = u256FromLimbs(*(*[4]uint64)(z[4:]))
+	lo = u256FromLimbs(*(*[4]uint64)(z[:4]))
+	return hi, lo
+}
 
-	// x^0 = 1
-	if y.IsZero() {
-		return U256From64(1)
+// mod returns x mod m.
+func (x Uint256) mod(m Uint256) Uint256 {
+	if x.Cmp(m) < 0 {
+		return x
 	}
+	_, r := x.QuoRem(m)
+	return r
+}
 
-	// x^1 mod m == x mod m
-	mod := !m.IsZero()
-	if y == U256From64(1) && mod {
-		_, r := x.QuoRem(m)
-		return r
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint256) AddMod(y, m Uint256) Uint256 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
 	}
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint256) MulMod(y, m Uint256) Uint256 {
+	hi, lo := x.mod(m).MulFull(y.mod(m))
+	_, r := div256(hi, lo, m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint256) SqrMod(m Uint256) Uint256 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint256) MulDiv(y, z Uint256) (Uint256, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint256) MulDivRoundUp(y, z Uint256) (Uint256, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
 
-	yv := []uint64{
-		y.u0, y.u1, y.u2,
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint256) MulDivRound(y, z Uint256, mode RoundingMode) (Uint256, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint256{}, false
 	}
-	i := len(yv)
-	for i > 0 && yv[i-1] == 0 {
-		i--
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint256.
+func (x Uint256) mulDiv(y, z Uint256) (q, r Uint256, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
+	}
+	hi, lo := x.MulFull(y)
+	if z.Cmp(hi) <= 0 {
+		return Uint256{}, Uint256{}, false
 	}
-	yv = yv[:i]
+	q, r = div256(hi, lo, z)
+	return q, r, true
+}
 
-	// TODO(eric): if x > 1 and y > 0 && mod, then use montgomery
-	// or windowed exponentiation.
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint256) Exp(y, m Uint256) Uint256 {
+	switch {
+	case m.IsZero():
+		return x.exp(y)
+	case m == U256From64(1):
+		return Uint256{}
+	case m.u0&1 != 0:
+		// NewMont256 only fails for even moduli.
+		c, _ := NewMont256(m)
+		return c.Exp(x, y)
+	}
 
-	z := x
-	v := yv[len(yv)-1]
-	s := bits.LeadingZeros64(v) + 1
-	v <<= s
-	w := 64 - s
-	for j := 0; j < w; j++ {
-		z = z.Mul(z)
-		if v&mask != 0 {
-			z = z.Mul(x)
-		}
-		if mod {
-			_, z = z.QuoRem(m)
+	z := U256From64(1)
+	x = x.mod(m)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.SqrMod(m)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.MulMod(x, m)
 		}
-		v <<= 1
 	}
+	return z
+}
 
-	for i := len(yv) - 2; i >= 0; i-- {
-		v := yv[i]
-		for j := 0; j < 64; j++ {
-			z = z.Mul(z)
-			if v&mask != 0 {
-				z = z.Mul(x)
-			}
-			if mod {
-				_, z = z.QuoRem(m)
-			}
-			v <<= 1
+// exp returns x^y.
+func (x Uint256) exp(y Uint256) Uint256 {
+	z := U256From64(1)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.Mul(z)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.Mul(x)
 		}
 	}
 	return z
@@ -497,11 +820,10 @@ var pow10tabUint256 struct {
 
 func pow10Uint256(n uint) Uint256 {
 	pow10tabUint256.once.Do(func() {
-		tab := make([]Uint256, 2+78)
-		tab[0] = Uint256{}
-		tab[1] = U256From64(1)
-		for i := 2; i < len(tab); i++ {
-			tab[i] = tab[i-1].mul64(10)
+		tab := make([]Uint256, 78)
+		tab[0] = U256From64(1)
+		for i := 1; i < len(tab); i++ {
+			tab[i] = tab[i-1].Mul64(10)
 		}
 		pow10tabUint256.values = tab
 	})
@@ -511,7 +833,10 @@ func pow10Uint256(n uint) Uint256 {
 // MulCheck returns x*y and reports whether the multiplication
 // oveflowed.
 func (x Uint256) MulCheck(y Uint256) (Uint256, bool) {
-	if x.BitLen()+y.BitLen() > 256 {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > 256+1 {
 		return Uint256{}, false
 	}
 
@@ -559,7 +884,9 @@ func (x Uint256) MulCheck(y Uint256) (Uint256, bool) {
 	return z, true
 }
 
-func (x Uint256) mulCheck64(y uint64) (Uint256, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint256) MulCheck64(y uint64) (Uint256, bool) {
 	if y == 0 {
 		return Uint256{}, true
 	}
@@ -575,6 +902,89 @@ func (x Uint256) mulCheck64(y uint64) (Uint256, bool) {
 	return z, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint256 if x+y
+// overflows.
+func (x Uint256) SaturatingAdd(y Uint256) Uint256 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint256) SaturatingSub(y Uint256) Uint256 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint256{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint256 if x*y
+// overflows.
+func (x Uint256) SaturatingMul(y Uint256) Uint256 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint256 if any set
+// bits are shifted out.
+func (x Uint256) SaturatingLsh(n uint) Uint256 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint256) Quo(y Uint256) Uint256 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint256) Rem(y Uint256) Uint256 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint256) QuoCeil(y Uint256) Uint256 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint256) QuoRound(y Uint256, mode RoundingMode) Uint256 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint256.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint256) QuoRemCheck(y Uint256) (q, r Uint256, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -597,13 +1007,13 @@ func (x Uint256) QuoRem(y Uint256) (q, r Uint256) {
 	tq, _ := div128(x1.high(), x1.low(), y1.high())
 	tq = tq.Rsh(127 - n) // tq >>= 127 - n
 	if !tq.IsZero() {
-		tq = tq.sub64(1) // tq--
+		tq = tq.Sub64(1) // tq--
 	}
 	q = u256(tq, Uint128{})
 	ytq := y.mul128(tq) // ytq := y*tq
 	r = x.Sub(ytq)      // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
@@ -623,11 +1033,11 @@ func (x Uint256) quoRem128(y Uint128) (q Uint256, r Uint128) {
 	return u256(lo, hi), r
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint256) quoRem64(y uint64) (q Uint256, r uint64) {
+func (x Uint256) QuoRem64(y uint64) (q Uint256, r uint64) {
 	q.u3, r = bits.Div64(0, x.u3, y)
 	q.u2, r = bits.Div64(r, x.u2, y)
 	q.u1, r = bits.Div64(r, x.u1, y)
@@ -662,7 +1072,7 @@ func div256(hi, lo, y Uint256) (q, r Uint256) {
 
 	// for q1 >= two128 || q1*yn0 > two128*rhat+un1 { ... }
 	for !q1.high().IsZero() || q1.mul128(yn0).Cmp(u256(un1, rhat)) > 0 {
-		q1 = q1.sub64(1)             // q1--
+		q1 = q1.Sub64(1)             // q1--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -675,7 +1085,7 @@ func div256(hi, lo, y Uint256) (q, r Uint256) {
 
 	// for q0 >= two128 || q0*yn0 > two128*rhat+un0 { ... }
 	for !q0.high().IsZero() || q0.mul128(yn0).Cmp(u256(un0, rhat)) > 0 {
-		q0 = q0.sub64(1)             // q0--
+		q0 = q0.Sub64(1)             // q0--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -689,8 +1099,24 @@ func div256(hi, lo, y Uint256) (q, r Uint256) {
 	return
 }
 
+// DivFull256 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull256 panics for y == 0 (division by zero) or
+// y <= hi (quotient overflow).
+//
+// It is the 256-bit analogue of [bits.Div64].
+func DivFull256(hi, lo, y Uint256) (q, r Uint256) {
+	return div256(hi, lo, y)
+}
+
+// GoString returns x as a call to [U256] that produces x.
 func (x Uint256) GoString() string {
-	return fmt.Sprintf("[%d %d %d %d]",
+	return fmt.Sprintf("fixed.U256(%#x, %#x, %#x, %#x)",
 		x.u0,
 		x.u1,
 		x.u2,
@@ -698,19 +1124,98 @@ func (x Uint256) GoString() string {
 	)
 }
 
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U256].
+func (x Uint256) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint256) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint256) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint256) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
+}
+
 // String returns the base-10 representation of x.
 func (x Uint256) String() string {
-	b := make([]byte, 78)
+	var b [78]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint256) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint256) AppendDecimal(dst []byte) []byte {
+	var b [78]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint256.
+func (x Uint256) FormatInto(b *[78]byte) []byte {
 	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint256 returns the value of s in the given base.
@@ -718,3 +1223,118 @@ func ParseUint256(s string, base int) (Uint256, error) {
 	x, _, _, err := parseUint[Uint256](s, base, false)
 	return x, err
 }
+
+// Mont256 is a Montgomery arithmetic context for an odd
+// 256-bit modulus.
+//
+// Values in Montgomery form are represented as x*R mod m, where
+// R = 2^256.
+//
+// Mont256 is not constant time and should not be used with
+// secret exponents.
+type Mont256 struct {
+	m   [4]uint64
+	r2  Uint256 // R^2 mod m
+	one Uint256 // R mod m
+	k   uint64  // -m^-1 mod 2^64
+}
+
+var _ Mont[Uint256] = (*Mont256)(nil)
+
+// NewMont256 creates a Montgomery context for the odd modulus
+// m.
+func NewMont256(m Uint256) (*Mont256, error) {
+	if m.u0&1 == 0 {
+		return nil, errEvenModulus
+	}
+	_, one := Uint256{}.Sub(m).QuoRem(m) // 2^256 mod m
+	r2 := one
+	for i := 0; i < 256; i++ {
+		// r2 = 2*r2 mod m
+		var c uint64
+		r2, c = r2.AddCheck(r2)
+		if c != 0 || r2.Cmp(m) >= 0 {
+			r2 = r2.Sub(m)
+		}
+	}
+	return &Mont256{
+		m:   m.limbs(),
+		r2:  r2,
+		one: one,
+		k:   montK(m.u0),
+	}, nil
+}
+
+// Modulus returns the modulus m.
+func (c *Mont256) Modulus() Uint256 {
+	return u256FromLimbs(c.m)
+}
+
+// ToMont converts x to Montgomery form.
+func (c *Mont256) ToMont(x Uint256) Uint256 {
+	if m := c.Modulus(); x.Cmp(m) >= 0 {
+		_, x = x.QuoRem(m)
+	}
+	return c.MulMont(x, c.r2)
+}
+
+// FromMont converts x from Montgomery form.
+func (c *Mont256) FromMont(x Uint256) Uint256 {
+	return c.MulMont(x, U256From64(1))
+}
+
+// MulMont returns x*y*R^-1 mod m.
+//
+// Both x and y must be less than m. If x and y are in
+// Montgomery form, then so is the result.
+func (c *Mont256) MulMont(x, y Uint256) Uint256 {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [4]uint64
+	var t [4 + 2]uint64
+	montMul(z[:], xv[:], yv[:], c.m[:], t[:], c.k)
+	return u256FromLimbs(z)
+}
+
+// ExpMont returns x^y mod m, where x and the result are in
+// Montgomery form.
+//
+// x must be less than m.
+func (c *Mont256) ExpMont(x, y Uint256) Uint256 {
+	// Fixed 4-bit window.
+	var tab [16]Uint256
+	tab[0] = c.one
+	tab[1] = x
+	for i := 2; i < len(tab); i++ {
+		tab[i] = c.MulMont(tab[i-1], x)
+	}
+
+	z := c.one
+	started := false
+	yv := y.limbs()
+	for i := len(yv) - 1; i >= 0; i-- {
+		for s := 60; s >= 0; s -= 4 {
+			w := (yv[i] >> s) & 15
+			if !started {
+				if w != 0 {
+					z = tab[w]
+					started = true
+				}
+				continue
+			}
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			if w != 0 {
+				z = c.MulMont(z, tab[w])
+			}
+		}
+	}
+	return z
+}
+
+// Exp returns x^y mod m.
+func (c *Mont256) Exp(x, y Uint256) Uint256 {
+	return c.FromMont(c.ExpMont(c.ToMont(x), y))
+}
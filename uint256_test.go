@@ -108,6 +108,23 @@ func TestUint256Cmp(t *testing.T) {
 	}
 }
 
+func TestUint256Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint256()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint256And(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint256()
@@ -223,7 +240,7 @@ func TestUint256Add64(t *testing.T) {
 		x := randUint256()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -233,9 +250,9 @@ func TestUint256Add64(t *testing.T) {
 		}
 		want.And(want, big256mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -274,7 +291,7 @@ func TestUint256Sub64(t *testing.T) {
 		x := randUint256()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -284,9 +301,9 @@ func TestUint256Sub64(t *testing.T) {
 		}
 		want.And(want, big256mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -326,7 +343,7 @@ func TestUint256Mul64(t *testing.T) {
 		x := randUint256()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -336,11 +353,11 @@ func TestUint256Mul64(t *testing.T) {
 		}
 		want.And(want, big256mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -352,7 +369,7 @@ func TestUint256Exp(t *testing.T) {
 	x := U256From64(1)
 	ten := U256From64(10)
 	for i := 1; ; i++ {
-		want, ok := x.mulCheck64(10)
+		want, ok := x.MulCheck64(10)
 		if !ok {
 			break
 		}
@@ -364,6 +381,126 @@ func TestUint256Exp(t *testing.T) {
 	}
 }
 
+func TestUint256ExpMod(t *testing.T) {
+	for i := 0; i < 256; i++ {
+		x := randUint256()
+		y := randUint256()
+		m := randUint256()
+		if m.IsZero() {
+			m = U256From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint256MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint256()
+		y := randUint256()
+		m := randUint256()
+		if m.IsZero() {
+			m = U256From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint256AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint256()
+		y := randUint256()
+		m := randUint256()
+		if m.IsZero() {
+			m = U256From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint256MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint256()
+		y := randUint256()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 256)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull256(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint256()
+		lo := randUint256()
+		y := randUint256()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull256(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 256)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint256MulDiv(t *testing.T) {
+	testMulDiv(t, randUint256)
+}
+
+func TestUint256Quo(t *testing.T) {
+	testQuo(t, randUint256)
+}
+
+func TestUint256Saturating(t *testing.T) {
+	testSaturating(t, randUint256)
+}
+
+func TestUint256MulPow10(t *testing.T) {
+	testMulPow10(t, randUint256)
+}
+
 func TestUint256QuoRem(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint256()
@@ -424,7 +561,7 @@ func TestUint256QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -476,6 +613,70 @@ func TestParseUint256(t *testing.T) {
 	}
 }
 
+func randMont256() *Mont256 {
+	m := randUint256()
+	m.u0 |= 1
+	c, err := NewMont256(m)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func TestMont256Mul(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		c := randMont256()
+		m := c.Modulus()
+		x := randUint256()
+		y := randUint256()
+
+		z := c.FromMont(c.MulMont(c.ToMont(x), c.ToMont(y)))
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestMont256Exp(t *testing.T) {
+	for i := 0; i < 256; i++ {
+		c := randMont256()
+		m := c.Modulus()
+		x := randUint256()
+		y := randUint256()
+
+		z := c.Exp(x, y)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestNewMont256Even(t *testing.T) {
+	for _, m := range []Uint256{{}, U256From64(2), Uint256{}.max().Sub(U256From64(1))} {
+		if _, err := NewMont256(m); err == nil {
+			t.Fatalf("%d: expected an error", m.big())
+		}
+	}
+}
+
+func BenchmarkMont256Exp(b *testing.B) {
+	c := randMont256()
+	x := c.ToMont(randUint256())
+	y := Uint256{}.max()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink.Uint256 = c.ExpMont(x, y)
+	}
+}
+
 func BenchmarkUint256Add(b *testing.B) {
 	s := make([]Uint256, 1000)
 	for i := range s {
@@ -526,6 +727,6 @@ func BenchmarkUint256QuoRem(b *testing.B) {
 
 func BenchmarkUint256QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.Uint256, sink.uint64 = U256From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.Uint256, sink.uint64 = U256From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
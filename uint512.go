@@ -3,9 +3,11 @@
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"sync"
 )
@@ -38,6 +40,15 @@ func U512From64(x uint64) Uint512 {
 	return Uint512{u0: x}
 }
 
+// U512FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint512].
+func U512FromFloat64(f float64, mode RoundingMode) (Uint512, error) {
+	return fromFloat64[Uint512](f, mode)
+}
+
 func u512(lo, hi Uint256) Uint512 {
 	return Uint512{lo.u0, lo.u1, lo.u2, lo.u3, hi.u0, hi.u1, hi.u2, hi.u3}
 }
@@ -67,6 +78,21 @@ func (x Uint512) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+func (x Uint512) uint64() uint64 {
+	return x.u0
+}
+
+// limbs returns x as little-endian 64-bit words.
+func (x Uint512) limbs() [8]uint64 {
+	return [8]uint64{x.u0, x.u1, x.u2, x.u3, x.u4, x.u5, x.u6, x.u7}
+}
+
+// u512FromLimbs constructs a [Uint512] from little-endian
+// 64-bit words.
+func u512FromLimbs(v [8]uint64) Uint512 {
+	return Uint512{v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]}
+}
+
 // Bytes encodes x as a little-endian integer.
 func (x Uint512) Bytes(b *[64]byte) {
 	binary.LittleEndian.PutUint64(b[0:], x.u0)
@@ -96,6 +122,225 @@ func (x *Uint512) SetBytes(b []byte) error {
 	return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint512) BigEndianBytes(b *[64]byte) {
+	binary.BigEndian.PutUint64(b[0:], x.u7)
+	binary.BigEndian.PutUint64(b[8:], x.u6)
+	binary.BigEndian.PutUint64(b[16:], x.u5)
+	binary.BigEndian.PutUint64(b[24:], x.u4)
+	binary.BigEndian.PutUint64(b[32:], x.u3)
+	binary.BigEndian.PutUint64(b[40:], x.u2)
+	binary.BigEndian.PutUint64(b[48:], x.u1)
+	binary.BigEndian.PutUint64(b[56:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint512) SetBigEndianBytes(b []byte) error {
+	if len(b) != 64 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u7 = binary.BigEndian.Uint64(b[0:])
+	x.u6 = binary.BigEndian.Uint64(b[8:])
+	x.u5 = binary.BigEndian.Uint64(b[16:])
+	x.u4 = binary.BigEndian.Uint64(b[24:])
+	x.u3 = binary.BigEndian.Uint64(b[32:])
+	x.u2 = binary.BigEndian.Uint64(b[40:])
+	x.u1 = binary.BigEndian.Uint64(b[48:])
+	x.u0 = binary.BigEndian.Uint64(b[56:])
+	return nil
+}
+
+// AppendBytes appends the 64-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint512) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint64(dst, x.u1)
+		dst = order.AppendUint64(dst, x.u2)
+		dst = order.AppendUint64(dst, x.u3)
+		dst = order.AppendUint64(dst, x.u4)
+		dst = order.AppendUint64(dst, x.u5)
+		dst = order.AppendUint64(dst, x.u6)
+		dst = order.AppendUint64(dst, x.u7)
+		return dst
+	}
+	dst = order.AppendUint64(dst, x.u7)
+	dst = order.AppendUint64(dst, x.u6)
+	dst = order.AppendUint64(dst, x.u5)
+	dst = order.AppendUint64(dst, x.u4)
+	dst = order.AppendUint64(dst, x.u3)
+	dst = order.AppendUint64(dst, x.u2)
+	dst = order.AppendUint64(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint512) FillBytes(buf []byte) []byte {
+	var b [64]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 64 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 64 bytes.
+func (x *Uint512) SetBytesPadded(b []byte) error {
+	if len(b) > 64 {
+		return ErrOverflow
+	}
+	var be [64]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 64 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint512) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 64)
+	_ = x.SetBytesPadded(b) // len(b) <= 64
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint512) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint512) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint512.Bytes].
+func (x Uint512) MarshalBinary() ([]byte, error) {
+	var b [64]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint512.SetBytes].
+func (x *Uint512) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint512) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint512) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint512) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint512) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint512].
+func (x *Uint512) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint512) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint512) FillBig(z *big.Int) *big.Int {
+	v := x.limbs()
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^512 and reports whether v is
+// negative or does not fit in 512 bits.
+func (x *Uint512) SetBig(v *big.Int) (overflow bool) {
+	var z [8]uint64
+	overflow = setBig(z[:], v, 512)
+	*x = u512FromLimbs(z)
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint512) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^512, and reports whether the rounded value is
+// negative or does not fit in 512 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint512) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint512{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint512) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint512) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint512) Size() int {
 	return 512
@@ -159,12 +404,12 @@ func (x Uint512) Cmp(y Uint512) int {
 	}
 }
 
-// cmp64 compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint512) cmp64(y uint64) int {
+func (x Uint512) Cmp64(y uint64) int {
 	v := x
 	v.u0 = 0
 	if !v.IsZero() {
@@ -404,8 +649,8 @@ func (x Uint512) Add(y Uint512) Uint512 {
 	return z
 }
 
-// add64 returns x+y.
-func (x Uint512) add64(y uint64) Uint512 {
+// Add64 returns x+y.
+func (x Uint512) Add64(y uint64) Uint512 {
 	var z Uint512
 	var carry uint64
 	z.u0, carry = bits.Add64(x.u0, y, 0)
@@ -434,10 +679,10 @@ func (x Uint512) AddCheck(y Uint512) (z Uint512, carry uint64) {
 	return z, carry
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint512) addCheck64(y uint64) (z Uint512, carry uint64) {
+func (x Uint512) AddCheck64(y uint64) (z Uint512, carry uint64) {
 	z.u0, carry = bits.Add64(x.u0, y, 0)
 	z.u1, carry = bits.Add64(x.u1, 0, carry)
 	z.u2, carry = bits.Add64(x.u2, 0, carry)
@@ -464,8 +709,8 @@ func (x Uint512) Sub(y Uint512) Uint512 {
 	return z
 }
 
-// sub64 returns x-y.
-func (x Uint512) sub64(y uint64) Uint512 {
+// Sub64 returns x-y.
+func (x Uint512) Sub64(y uint64) Uint512 {
 	var z Uint512
 	var borrow uint64
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
@@ -494,10 +739,10 @@ func (x Uint512) SubCheck(y Uint512) (z Uint512, borrow uint64) {
 	return z, borrow
 }
 
-// subCheck64 returns x-y.
+// SubCheck64 returns x-y.
 //
 // borrow is 1 if x-y overflows and 0 otherwise.
-func (x Uint512) subCheck64(y uint64) (z Uint512, borrow uint64) {
+func (x Uint512) SubCheck64(y uint64) (z Uint512, borrow uint64) {
 	z.u0, borrow = bits.Sub64(x.u0, y, 0)
 	z.u1, borrow = bits.Sub64(x.u1, 0, borrow)
 	z.u2, borrow = bits.Sub64(x.u2, 0, borrow)
@@ -634,7 +879,8 @@ func (x Uint512) mul256(y Uint256) Uint512 {
 	return z
 }
 
-func (x Uint512) mul64(y uint64) Uint512 {
+// Mul64 returns x*y.
+func (x Uint512) Mul64(y uint64) Uint512 {
 	if y == 0 {
 		return Uint512{}
 	}
@@ -651,63 +897,156 @@ func (x Uint512) mul64(y uint64) Uint512 {
 	return z
 }
 
-// Exp return x^y mod m.
+// MulFull returns the full 2*512-bit product x*y as
+// (hi, lo).
 //
-// If m == 0, Exp simply returns x^y.
-func (x Uint512) Exp(y, m Uint512) Uint512 {
-	const mask = 1 << (64 - 1)
+// It is the 512-bit analogue of [bits.Mul64].
+func (x Uint512) MulFull(y Uint512) (hi, lo Uint512) {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [2 * 8]uint64
+	for i, d := range yv {
+		if d == 0 {
+			continue
+		}
+		var c uint64
+		for j, v := range xv {
+			c, z[i+j] = mulAddWWWW(v, d, z[i+j], c)
+		}
+		z[i+len(xv)] = c
+	}
+	hi = u512FromLimbs(*(*[8]uint64)(z[8:]))
+	lo = u512FromLimbs(*(*[8]uint64)(z[:8]))
+	return hi, lo
+}
 
-	// x^0 = 1
-	if y.IsZero() {
-		return U512From64(1)
+// mod returns x mod m.
+func (x Uint512) mod(m Uint512) Uint512 {
+	if x.Cmp(m) < 0 {
+		return x
 	}
+	_, r := x.QuoRem(m)
+	return r
+}
 
-	// x^1 mod m == x mod m
-	mod := !m.IsZero()
-	if y == U512From64(1) && mod {
-		_, r := x.QuoRem(m)
-		return r
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint512) AddMod(y, m Uint512) Uint512 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
 	}
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint512) MulMod(y, m Uint512) Uint512 {
+	hi, lo := x.mod(m).MulFull(y.mod(m))
+	_, r := div512(hi, lo, m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint512) SqrMod(m Uint512) Uint512 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint512) MulDiv(y, z Uint512) (Uint512, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint512) MulDivRoundUp(y, z Uint512) (Uint512, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
 
-	yv := []uint64{
-		y.u0, y.u1, y.u2, y.u3, y.u4, y.u5, y.u6,
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint512) MulDivRound(y, z Uint512, mode RoundingMode) (Uint512, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint512{}, false
 	}
-	i := len(yv)
-	for i > 0 && yv[i-1] == 0 {
-		i--
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint512.
+func (x Uint512) mulDiv(y, z Uint512) (q, r Uint512, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
 	}
-	yv = yv[:i]
+	hi, lo := x.MulFull(y)
+	if z.Cmp(hi) <= 0 {
+		return Uint512{}, Uint512{}, false
+	}
+	q, r = div512(hi, lo, z)
+	return q, r, true
+}
 
-	// TODO(eric): if x > 1 and y > 0 && mod, then use montgomery
-	// or windowed exponentiation.
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint512) Exp(y, m Uint512) Uint512 {
+	switch {
+	case m.IsZero():
+		return x.exp(y)
+	case m == U512From64(1):
+		return Uint512{}
+	case m.u0&1 != 0:
+		// NewMont512 only fails for even moduli.
+		c, _ := NewMont512(m)
+		return c.Exp(x, y)
+	}
 
-	z := x
-	v := yv[len(yv)-1]
-	s := bits.LeadingZeros64(v) + 1
-	v <<= s
-	w := 64 - s
-	for j := 0; j < w; j++ {
-		z = z.Mul(z)
-		if v&mask != 0 {
-			z = z.Mul(x)
+	z := U512From64(1)
+	x = x.mod(m)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.SqrMod(m)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.MulMod(x, m)
 		}
-		if mod {
-			_, z = z.QuoRem(m)
-		}
-		v <<= 1
 	}
+	return z
+}
 
-	for i := len(yv) - 2; i >= 0; i-- {
-		v := yv[i]
-		for j := 0; j < 64; j++ {
-			z = z.Mul(z)
-			if v&mask != 0 {
-				z = z.Mul(x)
-			}
-			if mod {
-				_, z = z.QuoRem(m)
-			}
-			v <<= 1
+// exp returns x^y.
+func (x Uint512) exp(y Uint512) Uint512 {
+	z := U512From64(1)
+	yv := y.limbs()
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		z = z.Mul(z)
+		if yv[i/64]>>(i%64)&1 != 0 {
+			z = z.Mul(x)
 		}
 	}
 	return z
@@ -720,8 +1059,10 @@ func (x Uint512) mulPow10(n uint) (Uint512, bool) {
 		return Uint512{}, true
 	case n == 0:
 		return x, true
+	case n >= 155:
+		return Uint512{}, false
 	default:
-		return x.MulCheck(U512From64(10).Exp(U512From64(uint64(n)), U512From64(0)))
+		return x.MulCheck(U512From64(10).exp(U512From64(uint64(n))))
 	}
 }
 
@@ -732,11 +1073,10 @@ var pow10tabUint512 struct {
 
 func pow10Uint512(n uint) Uint512 {
 	pow10tabUint512.once.Do(func() {
-		tab := make([]Uint512, 2+155)
-		tab[0] = Uint512{}
-		tab[1] = U512From64(1)
-		for i := 2; i < len(tab); i++ {
-			tab[i] = tab[i-1].mul64(10)
+		tab := make([]Uint512, 155)
+		tab[0] = U512From64(1)
+		for i := 1; i < len(tab); i++ {
+			tab[i] = tab[i-1].Mul64(10)
 		}
 		pow10tabUint512.values = tab
 	})
@@ -746,7 +1086,10 @@ func pow10Uint512(n uint) Uint512 {
 // MulCheck returns x*y and reports whether the multiplication
 // oveflowed.
 func (x Uint512) MulCheck(y Uint512) (Uint512, bool) {
-	if x.BitLen()+y.BitLen() > 512 {
+	// The product of an m-bit and n-bit integer has either m+n
+	// or m+n-1 bits, so only the first case is known to
+	// overflow up front.
+	if x.BitLen()+y.BitLen() > 512+1 {
 		return Uint512{}, false
 	}
 
@@ -848,7 +1191,9 @@ func (x Uint512) MulCheck(y Uint512) (Uint512, bool) {
 	return z, true
 }
 
-func (x Uint512) mulCheck64(y uint64) (Uint512, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint512) MulCheck64(y uint64) (Uint512, bool) {
 	if y == 0 {
 		return Uint512{}, true
 	}
@@ -868,6 +1213,89 @@ func (x Uint512) mulCheck64(y uint64) (Uint512, bool) {
 	return z, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint512 if x+y
+// overflows.
+func (x Uint512) SaturatingAdd(y Uint512) Uint512 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint512) SaturatingSub(y Uint512) Uint512 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint512{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint512 if x*y
+// overflows.
+func (x Uint512) SaturatingMul(y Uint512) Uint512 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint512 if any set
+// bits are shifted out.
+func (x Uint512) SaturatingLsh(n uint) Uint512 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint512) Quo(y Uint512) Uint512 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint512) Rem(y Uint512) Uint512 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint512) QuoCeil(y Uint512) Uint512 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint512) QuoRound(y Uint512, mode RoundingMode) Uint512 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint512.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint512) QuoRemCheck(y Uint512) (q, r Uint512, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -890,13 +1318,13 @@ func (x Uint512) QuoRem(y Uint512) (q, r Uint512) {
 	tq, _ := div256(x1.high(), x1.low(), y1.high())
 	tq = tq.Rsh(255 - n) // tq >>= 255 - n
 	if !tq.IsZero() {
-		tq = tq.sub64(1) // tq--
+		tq = tq.Sub64(1) // tq--
 	}
 	q = u512(tq, Uint256{})
 	ytq := y.mul256(tq) // ytq := y*tq
 	r = x.Sub(ytq)      // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q++
+		q = q.Add64(1) // q++
 		r = r.Sub(y)   // r -= y
 	}
 	return
@@ -916,11 +1344,11 @@ func (x Uint512) quoRem256(y Uint256) (q Uint512, r Uint256) {
 	return u512(lo, hi), r
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint512) quoRem64(y uint64) (q Uint512, r uint64) {
+func (x Uint512) QuoRem64(y uint64) (q Uint512, r uint64) {
 	q.u7, r = bits.Div64(0, x.u7, y)
 	q.u6, r = bits.Div64(r, x.u6, y)
 	q.u5, r = bits.Div64(r, x.u5, y)
@@ -959,7 +1387,7 @@ func div512(hi, lo, y Uint512) (q, r Uint512) {
 
 	// for q1 >= two256 || q1*yn0 > two256*rhat+un1 { ... }
 	for !q1.high().IsZero() || q1.mul256(yn0).Cmp(u512(un1, rhat)) > 0 {
-		q1 = q1.sub64(1)             // q1--
+		q1 = q1.Sub64(1)             // q1--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -972,7 +1400,7 @@ func div512(hi, lo, y Uint512) (q, r Uint512) {
 
 	// for q0 >= two256 || q0*yn0 > two256*rhat+un0 { ... }
 	for !q0.high().IsZero() || q0.mul256(yn0).Cmp(u512(un0, rhat)) > 0 {
-		q0 = q0.sub64(1)             // q0--
+		q0 = q0.Sub64(1)             // q0--
 		rhat, c = rhat.AddCheck(yn1) // rhat += yn1
 		if c != 0 {
 			break
@@ -986,8 +1414,24 @@ func div512(hi, lo, y Uint512) (q, r Uint512) {
 	return
 }
 
+// DivFull512 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull512 panics for y == 0 (division by zero) or
+// y <= hi (quotient overflow).
+//
+// It is the 512-bit analogue of [bits.Div64].
+func DivFull512(hi, lo, y Uint512) (q, r Uint512) {
+	return div512(hi, lo, y)
+}
+
+// GoString returns x as a call to [U512] that produces x.
 func (x Uint512) GoString() string {
-	return fmt.Sprintf("[%d %d %d %d %d %d %d %d]",
+	return fmt.Sprintf("fixed.U512(%#x, %#x, %#x, %#x, %#x, %#x, %#x, %#x)",
 		x.u0,
 		x.u1,
 		x.u2,
@@ -999,19 +1443,98 @@ func (x Uint512) GoString() string {
 	)
 }
 
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U512].
+func (x Uint512) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint512) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint512) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint512) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
+}
+
 // String returns the base-10 representation of x.
 func (x Uint512) String() string {
-	b := make([]byte, 155)
+	var b [155]byte
+	return string(x.FormatInto(&b))
+}
+
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint512) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint512) AppendDecimal(dst []byte) []byte {
+	var b [155]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint512.
+func (x Uint512) FormatInto(b *[155]byte) []byte {
 	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return string(b[i:])
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint512 returns the value of s in the given base.
@@ -1019,3 +1542,118 @@ func ParseUint512(s string, base int) (Uint512, error) {
 	x, _, _, err := parseUint[Uint512](s, base, false)
 	return x, err
 }
+
+// Mont512 is a Montgomery arithmetic context for an odd
+// 512-bit modulus.
+//
+// Values in Montgomery form are represented as x*R mod m, where
+// R = 2^512.
+//
+// Mont512 is not constant time and should not be used with
+// secret exponents.
+type Mont512 struct {
+	m   [8]uint64
+	r2  Uint512 // R^2 mod m
+	one Uint512 // R mod m
+	k   uint64  // -m^-1 mod 2^64
+}
+
+var _ Mont[Uint512] = (*Mont512)(nil)
+
+// NewMont512 creates a Montgomery context for the odd modulus
+// m.
+func NewMont512(m Uint512) (*Mont512, error) {
+	if m.u0&1 == 0 {
+		return nil, errEvenModulus
+	}
+	_, one := Uint512{}.Sub(m).QuoRem(m) // 2^512 mod m
+	r2 := one
+	for i := 0; i < 512; i++ {
+		// r2 = 2*r2 mod m
+		var c uint64
+		r2, c = r2.AddCheck(r2)
+		if c != 0 || r2.Cmp(m) >= 0 {
+			r2 = r2.Sub(m)
+		}
+	}
+	return &Mont512{
+		m:   m.limbs(),
+		r2:  r2,
+		one: one,
+		k:   montK(m.u0),
+	}, nil
+}
+
+// Modulus returns the modulus m.
+func (c *Mont512) Modulus() Uint512 {
+	return u512FromLimbs(c.m)
+}
+
+// ToMont converts x to Montgomery form.
+func (c *Mont512) ToMont(x Uint512) Uint512 {
+	if m := c.Modulus(); x.Cmp(m) >= 0 {
+		_, x = x.QuoRem(m)
+	}
+	return c.MulMont(x, c.r2)
+}
+
+// FromMont converts x from Montgomery form.
+func (c *Mont512) FromMont(x Uint512) Uint512 {
+	return c.MulMont(x, U512From64(1))
+}
+
+// MulMont returns x*y*R^-1 mod m.
+//
+// Both x and y must be less than m. If x and y are in
+// Montgomery form, then so is the result.
+func (c *Mont512) MulMont(x, y Uint512) Uint512 {
+	xv := x.limbs()
+	yv := y.limbs()
+	var z [8]uint64
+	var t [8 + 2]uint64
+	montMul(z[:], xv[:], yv[:], c.m[:], t[:], c.k)
+	return u512FromLimbs(z)
+}
+
+// ExpMont returns x^y mod m, where x and the result are in
+// Montgomery form.
+//
+// x must be less than m.
+func (c *Mont512) ExpMont(x, y Uint512) Uint512 {
+	// Fixed 4-bit window.
+	var tab [16]Uint512
+	tab[0] = c.one
+	tab[1] = x
+	for i := 2; i < len(tab); i++ {
+		tab[i] = c.MulMont(tab[i-1], x)
+	}
+
+	z := c.one
+	started := false
+	yv := y.limbs()
+	for i := len(yv) - 1; i >= 0; i-- {
+		for s := 60; s >= 0; s -= 4 {
+			w := (yv[i] >> s) & 15
+			if !started {
+				if w != 0 {
+					z = tab[w]
+					started = true
+				}
+				continue
+			}
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			z = c.MulMont(z, z)
+			if w != 0 {
+				z = c.MulMont(z, tab[w])
+			}
+		}
+	}
+	return z
+}
+
+// Exp returns x^y mod m.
+func (c *Mont512) Exp(x, y Uint512) Uint512 {
+	return c.FromMont(c.ExpMont(c.ToMont(x), y))
+}
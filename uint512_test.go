@@ -132,6 +132,23 @@ func TestUint512Cmp(t *testing.T) {
 	}
 }
 
+func TestUint512Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint512()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint512And(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint512()
@@ -247,7 +264,7 @@ func TestUint512Add64(t *testing.T) {
 		x := randUint512()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -257,9 +274,9 @@ func TestUint512Add64(t *testing.T) {
 		}
 		want.And(want, big512mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -298,7 +315,7 @@ func TestUint512Sub64(t *testing.T) {
 		x := randUint512()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -308,9 +325,9 @@ func TestUint512Sub64(t *testing.T) {
 		}
 		want.And(want, big512mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -350,7 +367,7 @@ func TestUint512Mul64(t *testing.T) {
 		x := randUint512()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -360,11 +377,11 @@ func TestUint512Mul64(t *testing.T) {
 		}
 		want.And(want, big512mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -376,7 +393,7 @@ func TestUint512Exp(t *testing.T) {
 	x := U512From64(1)
 	ten := U512From64(10)
 	for i := 1; ; i++ {
-		want, ok := x.mulCheck64(10)
+		want, ok := x.MulCheck64(10)
 		if !ok {
 			break
 		}
@@ -388,6 +405,126 @@ func TestUint512Exp(t *testing.T) {
 	}
 }
 
+func TestUint512ExpMod(t *testing.T) {
+	for i := 0; i < 128; i++ {
+		x := randUint512()
+		y := randUint512()
+		m := randUint512()
+		if m.IsZero() {
+			m = U512From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint512MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint512()
+		y := randUint512()
+		m := randUint512()
+		if m.IsZero() {
+			m = U512From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint512AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint512()
+		y := randUint512()
+		m := randUint512()
+		if m.IsZero() {
+			m = U512From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint512MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 512), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint512()
+		y := randUint512()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 512)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull512(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint512()
+		lo := randUint512()
+		y := randUint512()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull512(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 512)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint512MulDiv(t *testing.T) {
+	testMulDiv(t, randUint512)
+}
+
+func TestUint512Quo(t *testing.T) {
+	testQuo(t, randUint512)
+}
+
+func TestUint512Saturating(t *testing.T) {
+	testSaturating(t, randUint512)
+}
+
+func TestUint512MulPow10(t *testing.T) {
+	testMulPow10(t, randUint512)
+}
+
 func TestUint512QuoRem(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint512()
@@ -448,7 +585,7 @@ func TestUint512QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -500,6 +637,70 @@ func TestParseUint512(t *testing.T) {
 	}
 }
 
+func randMont512() *Mont512 {
+	m := randUint512()
+	m.u0 |= 1
+	c, err := NewMont512(m)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func TestMont512Mul(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		c := randMont512()
+		m := c.Modulus()
+		x := randUint512()
+		y := randUint512()
+
+		z := c.FromMont(c.MulMont(c.ToMont(x), c.ToMont(y)))
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestMont512Exp(t *testing.T) {
+	for i := 0; i < 128; i++ {
+		c := randMont512()
+		m := c.Modulus()
+		x := randUint512()
+		y := randUint512()
+
+		z := c.Exp(x, y)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestNewMont512Even(t *testing.T) {
+	for _, m := range []Uint512{{}, U512From64(2), Uint512{}.max().Sub(U512From64(1))} {
+		if _, err := NewMont512(m); err == nil {
+			t.Fatalf("%d: expected an error", m.big())
+		}
+	}
+}
+
+func BenchmarkMont512Exp(b *testing.B) {
+	c := randMont512()
+	x := c.ToMont(randUint512())
+	y := Uint512{}.max()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink.Uint512 = c.ExpMont(x, y)
+	}
+}
+
 func BenchmarkUint512Add(b *testing.B) {
 	s := make([]Uint512, 1000)
 	for i := range s {
@@ -550,6 +751,6 @@ func BenchmarkUint512QuoRem(b *testing.B) {
 
 func BenchmarkUint512QuoRem64(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		sink.Uint512, sink.uint64 = U512From64(uint64(i + 2)).quoRem64(uint64(i + 1))
+		sink.Uint512, sink.uint64 = U512From64(uint64(i + 2)).QuoRem64(uint64(i + 1))
 	}
 }
@@ -1,6 +1,7 @@
 package fixed
 
 import (
+	"database/sql/driver"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -43,6 +44,15 @@ func U96From64(x uint64) Uint96 {
 	return Uint96{u0: x}
 }
 
+// U96FromFloat64 returns f rounded to an integer according to
+// mode.
+//
+// It returns an error if f is NaN, negative, or too large to
+// fit in a [Uint96].
+func U96FromFloat64(f float64, mode RoundingMode) (Uint96, error) {
+	return fromFloat64[Uint96](f, mode)
+}
+
 func (Uint96) max() Uint96 {
 	return Uint96{
 		math.MaxUint64,
@@ -59,6 +69,11 @@ func (x Uint96) uint8() uint8 {
 	return uint8(x.u0)
 }
 
+//lint:ignore U1000 used by [Uint].
+func (x Uint96) uint64() uint64 {
+	return x.u0
+}
+
 // digits returns the number of decimal digits required to
 // represent x.
 func (x Uint96) digits() int {
@@ -102,6 +117,201 @@ func (x *Uint96) SetBytes(b []byte) error {
 	return nil
 }
 
+// BigEndianBytes encodes x as a big-endian integer.
+func (x Uint96) BigEndianBytes(b *[12]byte) {
+	binary.BigEndian.PutUint32(b[0:], x.u1)
+	binary.BigEndian.PutUint64(b[4:], x.u0)
+}
+
+// SetBigEndianBytes sets x to the encoded big-endian integer b.
+func (x *Uint96) SetBigEndianBytes(b []byte) error {
+	if len(b) != 12 {
+		return fmt.Errorf("fixed: invalid length: %d", len(b))
+	}
+	x.u1 = binary.BigEndian.Uint32(b[0:])
+	x.u0 = binary.BigEndian.Uint64(b[4:])
+	return nil
+}
+
+// AppendBytes appends the 12-byte encoding of x to dst using
+// order and returns the extended slice.
+func (x Uint96) AppendBytes(dst []byte, order binary.AppendByteOrder) []byte {
+	if isLittleEndian(order) {
+		dst = order.AppendUint64(dst, x.u0)
+		dst = order.AppendUint32(dst, x.u1)
+		return dst
+	}
+	dst = order.AppendUint32(dst, x.u1)
+	dst = order.AppendUint64(dst, x.u0)
+	return dst
+}
+
+// FillBytes sets buf to the big-endian encoding of x,
+// zero-extended on the left, and returns buf.
+//
+// FillBytes panics if x does not fit in buf.
+func (x Uint96) FillBytes(buf []byte) []byte {
+	var b [12]byte
+	x.BigEndianBytes(&b)
+	return fillBytes(buf, b[:])
+}
+
+// SetBytesPadded sets x to the big-endian integer b, which
+// may be shorter than 12 bytes.
+//
+// It returns [ErrOverflow] if b is longer than 12 bytes.
+func (x *Uint96) SetBytesPadded(b []byte) error {
+	if len(b) > 12 {
+		return ErrOverflow
+	}
+	var be [12]byte
+	copy(be[len(be)-len(b):], b)
+	return x.SetBigEndianBytes(be[:])
+}
+
+// SetBytesTrunc sets x to the low-order 12 bytes of the
+// big-endian integer b, which may have any length.
+//
+// It reports whether any of the discarded bytes were non-zero.
+func (x *Uint96) SetBytesTrunc(b []byte) (overflow bool) {
+	b, overflow = truncBytes(b, 12)
+	_ = x.SetBytesPadded(b) // len(b) <= 12
+	return overflow
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// x is encoded in base 10.
+func (x Uint96) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// It accepts base 10 integers and "0x"-prefixed base 16
+// integers.
+func (x *Uint96) UnmarshalText(text []byte) error {
+	return unmarshalText(x, text)
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// x is encoded as a little-endian integer. See [Uint96.Bytes].
+func (x Uint96) MarshalBinary() ([]byte, error) {
+	var b [12]byte
+	x.Bytes(&b)
+	return b[:], nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// See [Uint96.SetBytes].
+func (x *Uint96) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data)
+}
+
+// MarshalJSON implements [encoding/json.Marshaler].
+//
+// x is encoded as a quoted base 10 string. Use [JSON] to
+// select a different [JSONFormat].
+func (x Uint96) MarshalJSON() ([]byte, error) {
+	return appendJSON(nil, x, JSONString)
+}
+
+// UnmarshalJSON implements [encoding/json.Unmarshaler].
+//
+// It accepts JSON numbers as well as JSON strings holding
+// either base 10 integers or "0x"-prefixed base 16 integers.
+func (x *Uint96) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(x, data)
+}
+
+// Value implements [database/sql/driver.Valuer].
+//
+// It returns the base 10 representation of x, which is
+// suitable for NUMERIC and DECIMAL columns. See [SQLScanner]
+// for the inverse.
+func (x Uint96) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// MarshalDER returns the DER encoding of x as an ASN.1 INTEGER.
+func (x Uint96) MarshalDER() ([]byte, error) {
+	return appendDER(nil, x), nil
+}
+
+// UnmarshalDER sets x to the DER-encoded ASN.1 INTEGER in data.
+//
+// It returns an error if data is not the minimal encoding of a
+// non-negative INTEGER, has trailing data, or does not fit in
+// a [Uint96].
+func (x *Uint96) UnmarshalDER(data []byte) error {
+	return unmarshalDER(x, data)
+}
+
+// ToBig returns x as a [big.Int].
+func (x Uint96) ToBig() *big.Int {
+	return x.FillBig(new(big.Int))
+}
+
+// FillBig sets z to x and returns z.
+//
+// FillBig reuses the storage of z, so it does not allocate if
+// z is already large enough to hold x.
+func (x Uint96) FillBig(z *big.Int) *big.Int {
+	v := [2]uint64{x.u0, uint64(x.u1)}
+	return fillBig(z, v[:])
+}
+
+// SetBig sets x to v modulo 2^96 and reports whether v is
+// negative or does not fit in 96 bits.
+func (x *Uint96) SetBig(v *big.Int) (overflow bool) {
+	var z [2]uint64
+	overflow = setBig(z[:], v, 96)
+	*x = Uint96{z[0], uint32(z[1])}
+	return overflow
+}
+
+// ToBigFloat returns x as a [big.Float] with precision prec,
+// rounded according to mode.
+//
+// If prec is zero, the result is exact.
+func (x Uint96) ToBigFloat(prec uint, mode big.RoundingMode) *big.Float {
+	return newBigFloat(x.ToBig(), prec, mode)
+}
+
+// SetBigFloat sets x to f rounded to an integer according to
+// mode, modulo 2^96, and reports whether the rounded value is
+// negative or does not fit in 96 bits.
+//
+// If f is infinite, x is set to zero and overflow is true.
+func (x *Uint96) SetBigFloat(f *big.Float, mode RoundingMode) (overflow bool) {
+	v, ok := roundBigFloat(f, mode)
+	if !ok {
+		*x = Uint96{}
+		return true
+	}
+	return x.SetBig(v)
+}
+
+// Float64 returns the float64 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float64, Float64 returns
+// (+Inf, [big.Above]).
+func (x Uint96) Float64() (float64, big.Accuracy) {
+	return toFloat64(x)
+}
+
+// Float32 returns the float32 value nearest to x, with ties
+// rounded to even, and the accuracy of the result.
+//
+// If x is too large to fit in a float32, Float32 returns
+// (+Inf, [big.Above]).
+func (x Uint96) Float32() (float32, big.Accuracy) {
+	return toFloat32(x)
+}
+
 // Size returns the width of the integer in bits.
 func (Uint96) Size() int {
 	return 96
@@ -141,12 +351,12 @@ func (x Uint96) Cmp(y Uint96) int {
 	}
 }
 
-// cmp compares x and y and returns
+// Cmp64 compares x and y and returns
 //
 //   - +1 if x > y
 //   - 0 if x == y
 //   - -1 if x < y
-func (x Uint96) cmp64(y uint64) int {
+func (x Uint96) Cmp64(y uint64) int {
 	if x.u1 != 0 {
 		return +1
 	}
@@ -214,8 +424,8 @@ func (x Uint96) Add(y Uint96) Uint96 {
 	return Uint96{u0, u1}
 }
 
-// add64 returns x+y.
-func (x Uint96) add64(y uint64) Uint96 {
+// Add64 returns x+y.
+func (x Uint96) Add64(y uint64) Uint96 {
 	u0, c0 := bits.Add64(x.u0, y, 0)
 	u1, _ := bits.Add32(x.u1, 0, uint32(c0))
 	return Uint96{u0, u1}
@@ -230,10 +440,10 @@ func (x Uint96) AddCheck(y Uint96) (z Uint96, carry uint32) {
 	return Uint96{u0, u1}, c1
 }
 
-// addCheck64 returns x+y.
+// AddCheck64 returns x+y.
 //
 // carry is 1 if x+y overflows and 0 otherwise.
-func (x Uint96) addCheck64(y uint64) (z Uint96, carry uint64) {
+func (x Uint96) AddCheck64(y uint64) (z Uint96, carry uint64) {
 	u0, c0 := bits.Add64(x.u0, y, 0)
 	u1, c1 := bits.Add32(x.u1, 0, uint32(c0))
 	return Uint96{u0, u1}, uint64(c1)
@@ -246,7 +456,8 @@ func (x Uint96) Sub(y Uint96) Uint96 {
 	return Uint96{u0, u1}
 }
 
-func (x Uint96) sub64(y uint64) Uint96 {
+// Sub64 returns x-y.
+func (x Uint96) Sub64(y uint64) Uint96 {
 	u0, b := bits.Sub64(x.u0, y, 0)
 	u1, _ := bits.Sub32(x.u1, 0, uint32(b))
 	return Uint96{u0, u1}
@@ -261,10 +472,13 @@ func (x Uint96) SubCheck(y Uint96) (z Uint96, borrow uint32) {
 	return Uint96{u0, u1}, b1
 }
 
-func (x Uint96) subCheck64(y uint64) (z Uint96, borrow uint32) {
+// SubCheck64 returns x-y.
+//
+// borrow is 1 if x-y underflows and 0 otherwise.
+func (x Uint96) SubCheck64(y uint64) (z Uint96, borrow uint64) {
 	u0, b0 := bits.Sub64(x.u0, y, 0)
 	u1, b1 := bits.Sub32(x.u1, 0, uint32(b0))
-	return Uint96{u0, u1}, b1
+	return Uint96{u0, u1}, uint64(b1)
 }
 
 // Mul returns x*y.
@@ -274,7 +488,8 @@ func (x Uint96) Mul(y Uint96) Uint96 {
 	return Uint96{u0, uint32(u1)}
 }
 
-func (x Uint96) mul64(y uint64) Uint96 {
+// Mul64 returns x*y.
+func (x Uint96) Mul64(y uint64) Uint96 {
 	u1, u0 := bits.Mul64(x.u0, y)
 	return Uint96{u0, uint32(u1 + uint64(x.u1)*y)}
 }
@@ -308,9 +523,11 @@ func (x Uint96) MulCheck(y Uint96) (Uint96, bool) {
 	return Uint96{u0, uint32(u1)}, true
 }
 
-func (x Uint96) mulCheck64(y uint64) (Uint96, bool) {
+// MulCheck64 returns x*y and reports false if the
+// multiplication overflowed.
+func (x Uint96) MulCheck64(y uint64) (Uint96, bool) {
 	if y == 0 {
-		return Uint96{}, false
+		return Uint96{}, true
 	}
 	c, u0 := bits.Mul64(x.u0, y)
 	c, u1 := mulAddWWW(uint64(x.u1), y, c)
@@ -320,6 +537,89 @@ func (x Uint96) mulCheck64(y uint64) (Uint96, bool) {
 	return Uint96{u0, uint32(u1)}, true
 }
 
+// SaturatingAdd returns x+y, or the maximum Uint96 if x+y
+// overflows.
+func (x Uint96) SaturatingAdd(y Uint96) Uint96 {
+	z, c := x.AddCheck(y)
+	if c != 0 {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingSub returns x-y, or zero if x-y underflows.
+func (x Uint96) SaturatingSub(y Uint96) Uint96 {
+	z, b := x.SubCheck(y)
+	if b != 0 {
+		return Uint96{}
+	}
+	return z
+}
+
+// SaturatingMul returns x*y, or the maximum Uint96 if x*y
+// overflows.
+func (x Uint96) SaturatingMul(y Uint96) Uint96 {
+	z, ok := x.MulCheck(y)
+	if !ok {
+		return x.max()
+	}
+	return z
+}
+
+// SaturatingLsh returns x<<n, or the maximum Uint96 if any set
+// bits are shifted out.
+func (x Uint96) SaturatingLsh(n uint) Uint96 {
+	if x.IsZero() {
+		return x
+	}
+	if n > uint(x.LeadingZeros()) {
+		return x.max()
+	}
+	return x.Lsh(n)
+}
+
+// Quo returns x/y, rounded toward zero.
+//
+// Since x and y are unsigned, Quo is also the Euclidean
+// quotient.
+//
+// Quo panics if y == 0.
+func (x Uint96) Quo(y Uint96) Uint96 {
+	q, _ := x.QuoRem(y)
+	return q
+}
+
+// Rem returns x%y.
+//
+// Since x and y are unsigned, Rem is also the Euclidean
+// modulus.
+//
+// Rem panics if y == 0.
+func (x Uint96) Rem(y Uint96) Uint96 {
+	_, r := x.QuoRem(y)
+	return r
+}
+
+// QuoCeil returns x/y, rounded up.
+//
+// QuoCeil panics if y == 0.
+func (x Uint96) QuoCeil(y Uint96) Uint96 {
+	return quoRound(x, y, ToPositiveInf)
+}
+
+// QuoRound returns x/y, rounded according to mode.
+//
+// QuoRound panics if y == 0.
+func (x Uint96) QuoRound(y Uint96, mode RoundingMode) Uint96 {
+	return quoRound(x, y, mode)
+}
+
+// QuoRemCheck is like [Uint96.QuoRem], but returns
+// [ErrDivisionByZero] instead of panicking if y == 0.
+func (x Uint96) QuoRemCheck(y Uint96) (q, r Uint96, err error) {
+	return quoRemCheck(x, y)
+}
+
 // QuoRem returns (q, r) such that
 //
 //	q = x/y
@@ -332,7 +632,7 @@ func (x Uint96) QuoRem(y Uint96) (q, r Uint96) {
 	}
 	if y.u1 == 0 {
 		// Fast path for a 64-bit y.
-		q, r64 := x.quoRem64(y.u0)
+		q, r64 := x.QuoRem64(y.u0)
 		return q, U96From64(r64)
 	}
 
@@ -347,20 +647,20 @@ func (x Uint96) QuoRem(y Uint96) (q, r Uint96) {
 		tq--
 	}
 	q = U96From64(tq)
-	ytq := y.mul64(tq) // ytq := y*tq
+	ytq := y.Mul64(tq) // ytq := y*tq
 	r = x.Sub(ytq)     // r = x-ytq
 	if r.Cmp(y) >= 0 {
-		q = q.add64(1) // q--
+		q = q.Add64(1) // q--
 		r = r.Sub(y)   // r -= y
 	}
 	return
 }
 
-// quoRem64 returns (q, r) such that
+// QuoRem64 returns (q, r) such that
 //
 //	q = x/y
 //	r = x - y*q
-func (x Uint96) quoRem64(y uint64) (q Uint96, r uint64) {
+func (x Uint96) QuoRem64(y uint64) (q Uint96, r uint64) {
 	if uint64(x.u1) < y {
 		lo, r := bits.Div64(uint64(x.u1), x.u0, y)
 		return Uint96{lo, 0}, r
@@ -370,31 +670,283 @@ func (x Uint96) quoRem64(y uint64) (q Uint96, r uint64) {
 	return Uint96{lo, uint32(hi)}, r
 }
 
+// DivFull96 returns the quotient and remainder of (hi, lo)
+// divided by y:
+//
+//	q = (hi, lo)/y
+//	r = (hi, lo) - y*q
+//
+// with the dividend bits' upper half in hi and lower half in
+// lo. DivFull96 panics for y == 0 (division by zero) or y <= hi
+// (quotient overflow).
+//
+// It is the 96-bit analogue of [bits.Div64].
+func DivFull96(hi, lo, y Uint96) (q, r Uint96) {
+	if y.IsZero() {
+		panic("integer divide by zero")
+	}
+	if y.Cmp(hi) <= 0 {
+		panic("integer overflow")
+	}
+	x := Uint192{
+		lo.u0,
+		uint64(lo.u1) | hi.u0<<32,
+		hi.u0>>32 | uint64(hi.u1)<<32,
+	}
+	// y > hi, so the quotient fits in 96 bits.
+	q192, r := x.quoRem96(y)
+	return Uint96{q192.u0, uint32(q192.u1)}, r
+}
+
+// MulFull returns the full 192-bit product x*y as (hi, lo).
+//
+// It is the 96-bit analogue of [bits.Mul64].
+func (x Uint96) MulFull(y Uint96) (hi, lo Uint96) {
+	// The 192-bit product always fits in a Uint192.
+	p := x.uint128().uint192().mul128(y.uint128())
+	return p.high(), Uint96{p.u0, uint32(p.u1)}
+}
+
+// mod returns x mod m.
+func (x Uint96) mod(m Uint96) Uint96 {
+	if x.Cmp(m) < 0 {
+		return x
+	}
+	_, r := x.QuoRem(m)
+	return r
+}
+
+// AddMod returns x+y mod m.
+//
+// AddMod panics if m == 0.
+func (x Uint96) AddMod(y, m Uint96) Uint96 {
+	x = x.mod(m)
+	y = y.mod(m)
+	z, c := x.AddCheck(y)
+	if c != 0 || z.Cmp(m) >= 0 {
+		z = z.Sub(m) // z -= m
+	}
+	return z
+}
+
+// MulMod returns x*y mod m.
+//
+// Unlike x.Mul(y).QuoRem(m), the product is computed at twice
+// the width of x, so the result is correct for every m.
+//
+// MulMod panics if m == 0.
+func (x Uint96) MulMod(y, m Uint96) Uint96 {
+	// The 192-bit product always fits in a Uint192.
+	p := x.mod(m).uint128().uint192().mul128(y.mod(m).uint128())
+	_, r := p.quoRem96(m)
+	return r
+}
+
+// SqrMod returns x*x mod m.
+//
+// SqrMod panics if m == 0.
+func (x Uint96) SqrMod(m Uint96) Uint96 {
+	return x.MulMod(x, m)
+}
+
+// MulDiv returns x*y/z, rounded toward zero.
+//
+// The product x*y is computed at twice the width of x, so
+// MulDiv reports false only if the quotient overflows.
+//
+// MulDiv panics if z == 0.
+func (x Uint96) MulDiv(y, z Uint96) (Uint96, bool) {
+	q, _, ok := x.mulDiv(y, z)
+	return q, ok
+}
+
+// MulDivRoundUp returns x*y/z, rounded up.
+//
+// It reports false if the result overflows.
+//
+// MulDivRoundUp panics if z == 0.
+func (x Uint96) MulDivRoundUp(y, z Uint96) (Uint96, bool) {
+	return x.MulDivRound(y, z, AwayFromZero)
+}
+
+// MulDivRound returns x*y/z, rounded according to mode.
+//
+// It reports false if the result overflows.
+//
+// MulDivRound panics if z == 0.
+func (x Uint96) MulDivRound(y, z Uint96, mode RoundingMode) (Uint96, bool) {
+	q, r, ok := x.mulDiv(y, z)
+	if !ok {
+		return Uint96{}, false
+	}
+	return roundQuo(q, r, z, false, false, mode)
+}
+
+// mulDiv returns (q, r) such that
+//
+//	q = x*y/z
+//	r = x*y - z*q
+//
+// and reports whether q fits in Uint96.
+func (x Uint96) mulDiv(y, z Uint96) (q, r Uint96, ok bool) {
+	if z.IsZero() {
+		panic("integer divide by zero")
+	}
+	// The 192-bit product always fits in a Uint192.
+	p := x.uint128().uint192().mul128(y.uint128())
+	q192, r := p.quoRem96(z)
+	if q192.u2 != 0 || q192.u1 > math.MaxUint32 {
+		return Uint96{}, Uint96{}, false
+	}
+	return Uint96{q192.u0, uint32(q192.u1)}, r, true
+}
+
+// Exp return x^y mod m.
+//
+// If m == 0, Exp simply returns x^y.
+func (x Uint96) Exp(y, m Uint96) Uint96 {
+	if m == U96From64(1) {
+		return Uint96{}
+	}
+	mod := !m.IsZero()
+	if mod {
+		x = x.mod(m)
+	}
+	z := U96From64(1)
+	for i := y.BitLen() - 1; i >= 0; i-- {
+		if mod {
+			z = z.SqrMod(m)
+		} else {
+			z = z.Mul(z)
+		}
+		if y.Rsh(uint(i)).u0&1 != 0 {
+			if mod {
+				z = z.MulMod(x, m)
+			} else {
+				z = z.Mul(x)
+			}
+		}
+	}
+	return z
+}
+
+// mulPow10 returns x * 10^n.
+func (x Uint96) mulPow10(n uint) (Uint96, bool) {
+	switch {
+	case x.IsZero():
+		return Uint96{}, true
+	case n == 0:
+		return x, true
+	case n >= uint(len(pow10tab96)):
+		return Uint96{}, false
+	default:
+		return x.MulCheck(pow10tab96[n])
+	}
+}
+
+// GoString returns x as a call to [U96] that produces x.
 func (x Uint96) GoString() string {
-	return fmt.Sprintf("[%d %d %d]",
-		uint32(x.u0), x.u0>>32, x.u1)
+	return fmt.Sprintf("fixed.U96(%#x, %#x)", x.u0, x.u1)
+}
+
+// Format implements [fmt.Formatter].
+//
+// It accepts the formats 'b' (binary), 'o' (octal with 0
+// prefix), 'O' (octal with 0o prefix), 'd' (decimal), 'x'
+// (lowercase hexadecimal), and 'X' (uppercase hexadecimal).
+// Also supported are the full suite of package fmt's format
+// flags for integral types, including '+' and ' ' for sign
+// control, '#' for leading zero in octal and for hexadecimal,
+// a leading "0x" or "0X" for "%#x" and "%#X" respectively,
+// specification of minimum digits precision, output field
+// width, space or zero padding, and '-' for left or right
+// justification.
+//
+// The "%#v" format prints x as a call to [U96].
+func (x Uint96) Format(s fmt.State, ch rune) {
+	format(x, s, ch)
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the formats 'b' (binary), 'o' (octal), 'd'
+// (decimal), 'x' and 'X' (hexadecimal), and 's' and 'v' (base
+// determined by a "0b", "0o", "0x", or "0" prefix, otherwise
+// decimal).
+func (x *Uint96) Scan(s fmt.ScanState, ch rune) error {
+	return scan(x, s, ch)
+}
+
+// Text returns the representation of x in the given base.
+//
+// Base must be between 2 and 62, inclusive. The result uses
+// the lower-case letters 'a' to 'z' for digit values 10 to 35,
+// and the upper-case letters 'A' to 'Z' for digit values 36 to
+// 61.
+func (x Uint96) Text(base int) string {
+	return string(appendText(nil, x, base))
+}
+
+// Append appends the string representation of x, as generated
+// by x.Text(base), to dst and returns the extended slice.
+func (x Uint96) Append(dst []byte, base int) []byte {
+	return appendText(dst, x, base)
 }
 
 // String returns the base-10 representation of x.
 func (x Uint96) String() string {
-	return string(x.append(nil))
+	var b [29]byte
+	return string(x.FormatInto(&b))
 }
 
-func (x Uint96) append(dst []byte) []byte {
+// AppendText implements [encoding.TextAppender].
+//
+// x is encoded in base 10. AppendText does not allocate unless
+// dst needs to grow.
+func (x Uint96) AppendText(dst []byte) ([]byte, error) {
+	return x.AppendDecimal(dst), nil
+}
+
+// AppendDecimal appends the base-10 representation of x to dst
+// and returns the extended slice.
+//
+// AppendDecimal does not allocate unless dst needs to grow.
+func (x Uint96) AppendDecimal(dst []byte) []byte {
+	var b [29]byte
+	return append(dst, x.FormatInto(&b)...)
+}
+
+// FormatInto writes the base-10 representation of x to the end
+// of b and returns the suffix of b that holds it.
+//
+// b is large enough to hold the base-10 representation of
+// every Uint96.
+func (x Uint96) FormatInto(b *[29]byte) []byte {
+	i := len(b)
 	if x.u1 == 0 {
-		return strconv.AppendUint(dst, x.u0, 10)
+		var s [20]byte
+		d := strconv.AppendUint(s[:0], x.u0, 10)
+		i -= copy(b[i-len(d):], d)
+		return b[i:]
 	}
-	b := make([]byte, maxUint96Digits)
-	i := len(b)
-	for x.cmp64(10) >= 0 {
-		q, r := x.quoRem64(10)
-		i--
-		b[i] = byte(r + '0')
+	for x.Cmp64(1e19) >= 0 {
+		q, r := x.QuoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			b[i] = byte(r%10 + '0')
+			r /= 10
+		}
 		x = q
 	}
+	r := x.u0
+	for r >= 10 {
+		i--
+		b[i] = byte(r%10 + '0')
+		r /= 10
+	}
 	i--
-	b[i] = byte(x.u0 + '0')
-	return append(dst, b[i:]...)
+	b[i] = byte(r + '0')
+	return b[i:]
 }
 
 // ParseUint96 returns the value of s in the given base.
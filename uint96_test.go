@@ -69,6 +69,23 @@ func TestUint96Cmp(t *testing.T) {
 	}
 }
 
+func TestUint96Cmp64(t *testing.T) {
+	for i := 0; i < 100_000; i++ {
+		x := randUint96()
+		y := randUint64()
+		if i%4 == 0 {
+			y = x.uint64()
+		}
+
+		got := x.Cmp64(y)
+		want := x.big().Cmp(new(big.Int).SetUint64(y))
+		if got != want {
+			t.Fatalf("Cmp64(%d, %d): expected %d, got %d",
+				x.big(), y, want, got)
+		}
+	}
+}
+
 func TestUint96Add(t *testing.T) {
 	for i := 0; i < 100_000; i++ {
 		x := randUint96()
@@ -99,7 +116,7 @@ func TestUint96Add64(t *testing.T) {
 		x := randUint96()
 		y := randUint64()
 
-		z, c := x.addCheck64(y)
+		z, c := x.AddCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Add(x.big(), ybig)
@@ -109,9 +126,9 @@ func TestUint96Add64(t *testing.T) {
 		}
 		want.And(want, big96mask)
 
-		if c == 0 && x.add64(y) != z {
+		if c == 0 && x.Add64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.add64(y), z)
+				i, x.big(), ybig, x.Add64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d + %d: expected %d, got %d",
@@ -150,7 +167,7 @@ func TestUint96Sub64(t *testing.T) {
 		x := randUint96()
 		y := randUint64()
 
-		z, b := x.subCheck64(y)
+		z, b := x.SubCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Sub(x.big(), ybig)
@@ -160,9 +177,9 @@ func TestUint96Sub64(t *testing.T) {
 		}
 		want.And(want, big96mask)
 
-		if b == 0 && x.sub64(y) != z {
+		if b == 0 && x.Sub64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.sub64(y), z)
+				i, x.big(), ybig, x.Sub64(y), z)
 		}
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d - %d: expected %d, got %d",
@@ -202,7 +219,7 @@ func TestUint96Mul64(t *testing.T) {
 		x := randUint96()
 		y := randUint64()
 
-		z, ok := x.mulCheck64(y)
+		z, ok := x.MulCheck64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		want := new(big.Int).Mul(x.big(), ybig)
@@ -212,11 +229,11 @@ func TestUint96Mul64(t *testing.T) {
 		}
 		want.And(want, big96mask)
 
-		if ok && x.mul64(y) != z {
+		if ok && x.Mul64(y) != z {
 			t.Fatalf("%d: %d * %d: %d != %d",
-				i, x.big(), ybig, x.mul64(y), z)
+				i, x.big(), ybig, x.Mul64(y), z)
 		}
-		z = x.mul64(y)
+		z = x.Mul64(y)
 		if got := z.big(); got.Cmp(want) != 0 {
 			t.Fatalf("%d: %d * %d: expected %d, got %d",
 				i, x.big(), ybig, want, got)
@@ -258,7 +275,7 @@ func TestUint96QuoRem64(t *testing.T) {
 			y = 1
 		}
 
-		q, r := x.quoRem64(y)
+		q, r := x.QuoRem64(y)
 
 		ybig := new(big.Int).SetUint64(y)
 		wantq := new(big.Int)
@@ -277,6 +294,142 @@ func TestUint96QuoRem64(t *testing.T) {
 	}
 }
 
+func TestUint96ExpMod(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		x := randUint96()
+		y := randUint96()
+		m := randUint96()
+		if m.IsZero() {
+			m = U96From64(1)
+		}
+
+		z := x.Exp(y, m)
+
+		want := new(big.Int).Exp(x.big(), y.big(), m.big())
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint96Exp(t *testing.T) {
+	mod := new(big.Int).Lsh(big.NewInt(1), 96)
+	for i := 0; i < 1000; i++ {
+		x := randUint96()
+		y := U96From64(uint64(rand.Intn(300)))
+
+		z := x.Exp(y, Uint96{})
+
+		want := new(big.Int).Exp(x.big(), y.big(), mod)
+		if got := z.big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^%d: expected %d, got %d",
+				x.big(), y.big(), want, got)
+		}
+	}
+}
+
+func TestUint96MulMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint96()
+		y := randUint96()
+		m := randUint96()
+		if m.IsZero() {
+			m = U96From64(1)
+		}
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.MulMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d * %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+
+		want = new(big.Int).Mul(x.big(), x.big())
+		want.Mod(want, m.big())
+		if got := x.SqrMod(m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d^2 mod %d: expected %d, got %d",
+				x.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint96AddMod(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		x := randUint96()
+		y := randUint96()
+		m := randUint96()
+		if m.IsZero() {
+			m = U96From64(1)
+		}
+
+		want := new(big.Int).Add(x.big(), y.big())
+		want.Mod(want, m.big())
+		if got := x.AddMod(y, m).big(); got.Cmp(want) != 0 {
+			t.Fatalf("%d + %d mod %d: expected %d, got %d",
+				x.big(), y.big(), m.big(), want, got)
+		}
+	}
+}
+
+func TestUint96MulFull(t *testing.T) {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 96), big.NewInt(1))
+	for i := 0; i < 10_000; i++ {
+		x := randUint96()
+		y := randUint96()
+
+		hi, lo := x.MulFull(y)
+
+		want := new(big.Int).Mul(x.big(), y.big())
+		wantLo := new(big.Int).And(want, mask)
+		wantHi := new(big.Int).Rsh(want, 96)
+		if hi.big().Cmp(wantHi) != 0 || lo.big().Cmp(wantLo) != 0 {
+			t.Fatalf("%d * %d: expected (%d, %d), got (%d, %d)",
+				x.big(), y.big(), wantHi, wantLo, hi.big(), lo.big())
+		}
+	}
+}
+
+func TestDivFull96(t *testing.T) {
+	for i := 0; i < 10_000; i++ {
+		hi := randUint96()
+		lo := randUint96()
+		y := randUint96()
+		if y.Cmp(hi) <= 0 {
+			hi, y = y, hi
+		}
+		if y.Cmp(hi) <= 0 {
+			continue
+		}
+
+		q, r := DivFull96(hi, lo, y)
+
+		x := new(big.Int).Lsh(hi.big(), 96)
+		x.Or(x, lo.big())
+		wantq, wantr := new(big.Int).QuoRem(x, y.big(), new(big.Int))
+		if q.big().Cmp(wantq) != 0 || r.big().Cmp(wantr) != 0 {
+			t.Fatalf("(%d, %d) / %d: expected (%d, %d), got (%d, %d)",
+				hi.big(), lo.big(), y.big(), wantq, wantr, q.big(), r.big())
+		}
+	}
+}
+
+func TestUint96MulDiv(t *testing.T) {
+	testMulDiv(t, randUint96)
+}
+
+func TestUint96Quo(t *testing.T) {
+	testQuo(t, randUint96)
+}
+
+func TestUint96Saturating(t *testing.T) {
+	testSaturating(t, randUint96)
+}
+
+func TestUint96MulPow10(t *testing.T) {
+	testMulPow10(t, randUint96)
+}
+
 func TestUint96String(t *testing.T) {
 	test := func(x Uint96) {
 		want := x.big().String()
@@ -326,7 +479,7 @@ func BenchmarkUint96Mul(b *testing.B) {
 
 func BenchmarkUint96QuoRem64(b *testing.B) {
 	b.Run("obvious", func(b *testing.B) {
-		benchmarkUint96QuoRem64(b, Uint96.quoRem64)
+		benchmarkUint96QuoRem64(b, Uint96.QuoRem64)
 	})
 	b.Run("reciprocal", func(b *testing.B) {
 		benchmarkUint96QuoRem64(b, Uint96.quoRem64Reciprocal)
@@ -1,5 +1,12 @@
 package fixed
 
+import (
+	"errors"
+	"io"
+)
+
+var errVarintOverflow = errors.New("fixed: varint overflows integer")
+
 // MaxVarintLen returns the maximum number of bytes needed to
 // represent a varint.
 func MaxVarintLen[T Uint[T]]() int {
@@ -9,7 +16,7 @@ func MaxVarintLen[T Uint[T]]() int {
 // AppendUvarint appends the unsigned varint encoding of x to b
 // and returns the resulting slice.
 func AppendUvarint[T Uint[T]](b []byte, v T) []byte {
-	for v.cmp64(0x80) >= 0 {
+	for v.Cmp64(0x80) >= 0 {
 		b = append(b, v.uint8()|0x80)
 		v = v.Rsh(7)
 	}
@@ -19,7 +26,7 @@ func AppendUvarint[T Uint[T]](b []byte, v T) []byte {
 // VarintLen returns the number of bytes required to encode x.
 func VarintLen[T Uint[T]](v T) int {
 	var n int
-	for v.cmp64(0x80) >= 0 {
+	for v.Cmp64(0x80) >= 0 {
 		n++
 		v = v.Rsh(7)
 	}
@@ -52,3 +59,82 @@ func Uvarint[T Uint[T]](b []byte) (T, int) {
 	}
 	return *new(T), 0
 }
+
+// AppendVarint appends the signed varint encoding of x to b and
+// returns the resulting slice.
+//
+// x is interpreted as a two's complement integer and encoded
+// with zig-zag encoding, like [encoding/binary.AppendVarint].
+func AppendVarint[T Uint[T]](b []byte, x T) []byte {
+	return AppendUvarint(b, zigzag(x))
+}
+
+// Varint parses a signed varint from b and returns that value
+// and the number of bytes read.
+//
+// The value is returned as a two's complement integer. See
+// [Uvarint] for the meaning of n.
+func Varint[T Uint[T]](b []byte) (x T, n int) {
+	ux, n := Uvarint[T](b)
+	return unzigzag(ux), n
+}
+
+// zigzag maps the two's complement integer x to an unsigned
+// integer so that values with small magnitudes are small.
+func zigzag[T Uint[T]](x T) T {
+	ux := x.Lsh(1)
+	if x.Rsh(uint(x.Size() - 1)).IsZero() {
+		return ux
+	}
+	return ux.Xor(x.max())
+}
+
+// unzigzag is the inverse of zigzag.
+func unzigzag[T Uint[T]](ux T) T {
+	x := ux.Rsh(1)
+	if ux.uint8()&1 == 0 {
+		return x
+	}
+	return x.Xor(x.max())
+}
+
+// ReadUvarint reads an unsigned varint from r.
+//
+// Like [encoding/binary.ReadUvarint], the error is [io.EOF] only
+// if no bytes were read. If an EOF happens after reading some
+// but not all the bytes, ReadUvarint returns
+// [io.ErrUnexpectedEOF].
+func ReadUvarint[T Uint[T]](r io.ByteReader) (T, error) {
+	bits := (*new(T)).Size()
+	maxLen := (bits + 7) / 7
+	maxVal := uint8((1 << (bits % 7)) - 1)
+
+	var x T
+	var s uint
+	for i := 0; i < maxLen; i++ {
+		c, err := r.ReadByte()
+		if err != nil {
+			if i > 0 && err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return x, err
+		}
+		if c < 0x80 {
+			if i == maxLen-1 && c > maxVal {
+				return x, errVarintOverflow
+			}
+			return x.orLsh64(uint64(c), s), nil
+		}
+		x = x.orLsh64(uint64(c&0x7f), s)
+		s += 7
+	}
+	return x, errVarintOverflow
+}
+
+// WriteUvarint writes the unsigned varint encoding of x to w and
+// returns the number of bytes written.
+func WriteUvarint[T Uint[T]](w io.Writer, x T) (int, error) {
+	// buf is large enough for the widest integer type.
+	var buf [(2048 + 7) / 7]byte
+	return w.Write(AppendUvarint(buf[:0], x))
+}
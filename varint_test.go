@@ -1,7 +1,12 @@
 package fixed
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	"testing"
 
 	gcmp "github.com/google/go-cmp/cmp"
@@ -25,8 +30,8 @@ func testUvarint[T Uint[T]](t *testing.T) {
 
 		var want T
 		for j := 0; j < 10_000; j++ {
-			want = want.add64(rand.Uint64())
-			want = want.mul64(rand.Uint64())
+			want = want.Add64(rand.Uint64())
+			want = want.Mul64(rand.Uint64())
 			b = AppendUvarint(b[:0], want)
 			if got := VarintLen(want); got != len(b) {
 				t.Fatalf("got %d, expected %d", got, len(b))
@@ -42,6 +47,180 @@ func testUvarint[T Uint[T]](t *testing.T) {
 	})
 }
 
+func TestVarint(t *testing.T) {
+	testVarint[Uint96](t)
+	testVarint[Uint128](t)
+	testVarint[Uint192](t)
+	testVarint[Uint256](t)
+	testVarint[Uint512](t)
+	testVarint[Uint1024](t)
+	testVarint[Uint2048](t)
+}
+
+func testVarint[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var zero T
+		for _, tc := range []struct {
+			x    T
+			want T
+		}{
+			{zero, zero},
+			{zero.Sub64(1), zero.Add64(1)}, // -1
+			{zero.Add64(1), zero.Add64(2)},
+			{zero.Sub64(2), zero.Add64(3)}, // -2
+			{zero.max().Rsh(1), zero.max().Sub64(1)},
+			{zero.max().Rsh(1).Add64(1), zero.max()}, // min
+		} {
+			if got := zigzag(tc.x); !gcmp.Equal(got, tc.want) {
+				t.Fatalf("zigzag(%s): expected %s, got %s", tc.x, tc.want, got)
+			}
+		}
+
+		var b []byte
+		var want T
+		for j := 0; j < 10_000; j++ {
+			want = want.Add64(rand.Uint64())
+			want = want.Mul64(rand.Uint64())
+			b = AppendVarint(b[:0], want)
+			got, n := Varint[T](b)
+			if n != len(b) {
+				t.Fatalf("got %d, expected %d", n, len(b))
+			}
+			if !gcmp.Equal(want, got) {
+				t.Fatalf("%s", gcmp.Diff(want, got))
+			}
+		}
+	})
+}
+
+func TestReadUvarint(t *testing.T) {
+	testReadUvarint[Uint96](t)
+	testReadUvarint[Uint128](t)
+	testReadUvarint[Uint192](t)
+	testReadUvarint[Uint256](t)
+	testReadUvarint[Uint512](t)
+	testReadUvarint[Uint1024](t)
+	testReadUvarint[Uint2048](t)
+}
+
+func testReadUvarint[T Uint[T]](t *testing.T) {
+	t.Run(fmt.Sprintf("%T", *new(T)), func(t *testing.T) {
+		var buf bytes.Buffer
+		var want T
+		for j := 0; j < 10_000; j++ {
+			want = want.Add64(rand.Uint64())
+			want = want.Mul64(rand.Uint64())
+			buf.Reset()
+			n, err := WriteUvarint(&buf, want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != VarintLen(want) || n != buf.Len() {
+				t.Fatalf("got %d, expected %d", n, VarintLen(want))
+			}
+			got, err := ReadUvarint[T](&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !gcmp.Equal(want, got) {
+				t.Fatalf("%s", gcmp.Diff(want, got))
+			}
+		}
+
+		max := MaxVarintLen[T]()
+		for _, tc := range []struct {
+			b   []byte
+			err error
+		}{
+			{nil, io.EOF},
+			{[]byte{0x80}, io.ErrUnexpectedEOF},
+			{AppendUvarint(nil, want)[:VarintLen(want)-1], io.ErrUnexpectedEOF},
+			{append(bytes.Repeat([]byte{0xff}, max-1), 0x7f), errVarintOverflow},
+			{bytes.Repeat([]byte{0x80}, max+1), errVarintOverflow},
+		} {
+			if _, err := ReadUvarint[T](bytes.NewReader(tc.b)); !errors.Is(err, tc.err) {
+				t.Fatalf("ReadUvarint(%x): expected %v, got %v", tc.b, tc.err, err)
+			}
+		}
+	})
+}
+
+// signExtend returns x as a two's complement Uint128.
+func signExtend(x int64) Uint128 {
+	if x < 0 {
+		return Uint128{uint64(x), math.MaxUint64}
+	}
+	return Uint128{uint64(x), 0}
+}
+
+func FuzzUvarint(f *testing.F) {
+	for _, x := range []uint64{0, 1, 0x7f, 0x80, math.MaxUint32, math.MaxUint64} {
+		f.Add(x)
+	}
+	f.Fuzz(func(t *testing.T, x uint64) {
+		want := binary.AppendUvarint(nil, x)
+		if got := AppendUvarint(nil, U128From64(x)); !bytes.Equal(got, want) {
+			t.Fatalf("AppendUvarint(%d): expected %x, got %x", x, want, got)
+		}
+		var buf bytes.Buffer
+		if _, err := WriteUvarint(&buf, U128From64(x)); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Fatalf("WriteUvarint(%d): expected %x, got %x", x, want, buf.Bytes())
+		}
+	})
+}
+
+func FuzzVarint(f *testing.F) {
+	for _, x := range []int64{0, 1, -1, 63, -64, 64, -65, math.MaxInt64, math.MinInt64} {
+		f.Add(x)
+	}
+	f.Fuzz(func(t *testing.T, x int64) {
+		want := binary.AppendVarint(nil, x)
+		if got := AppendVarint(nil, signExtend(x)); !bytes.Equal(got, want) {
+			t.Fatalf("AppendVarint(%d): expected %x, got %x", x, want, got)
+		}
+		got, n := Varint[Uint128](want)
+		if n != len(want) || got != signExtend(x) {
+			t.Fatalf("Varint(%x): expected (%d, %d), got (%#x, %d)", want, x, len(want), got, n)
+		}
+	})
+}
+
+func FuzzReadUvarint(f *testing.F) {
+	for _, b := range [][]byte{
+		nil,
+		{0x00},
+		{0x80},
+		{0xff, 0x01},
+		bytes.Repeat([]byte{0xff}, 10),
+		append(bytes.Repeat([]byte{0xff}, 9), 0x01),
+	} {
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		want, err := binary.ReadUvarint(bytes.NewReader(b))
+		got, err2 := ReadUvarint[Uint128](bytes.NewReader(b))
+		if err != nil {
+			// Uint128 accepts longer varints than uint64, so only
+			// compare EOF errors.
+			if (err == io.EOF || err == io.ErrUnexpectedEOF) && err2 != err {
+				t.Fatalf("ReadUvarint(%x): expected %v, got %v", b, err, err2)
+			}
+			return
+		}
+		if err2 != nil || got != U128From64(want) {
+			t.Fatalf("ReadUvarint(%x): expected (%d, nil), got (%s, %v)", b, want, got, err2)
+		}
+
+		wantV, wantN := binary.Uvarint(b)
+		if gotV, gotN := Uvarint[Uint128](b); wantN > 0 && (gotN != wantN || gotV != U128From64(wantV)) {
+			t.Fatalf("Uvarint(%x): expected (%d, %d), got (%s, %d)", b, wantV, wantN, gotV, gotN)
+		}
+	})
+}
+
 func BenchmarkAppendUvarint(b *testing.B) {
 	benchmarkAppendUvarint[Uint96](b)
 	benchmarkAppendUvarint[Uint128](b)
@@ -58,8 +237,8 @@ func benchmarkAppendUvarint[T Uint[T]](b *testing.B) {
 		buf := make([]byte, max)
 		var want T
 		for i := 0; i < 64; i++ {
-			want = want.add64(rand.Uint64())
-			want = want.mul64(rand.Uint64())
+			want = want.Add64(rand.Uint64())
+			want = want.Mul64(rand.Uint64())
 		}
 		b.SetBytes(int64(len(buf)))
 		b.ResetTimer()